@@ -0,0 +1,115 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/atotto/clipboard"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+var markdownLinkEscaper = strings.NewReplacer(`\`, `\\`, "[", `\[`, "]", `\]`)
+
+func copyCmd(text string, what string) tea.Cmd {
+	what = T(what)
+	if err := clipboard.WriteAll(text); err != nil {
+		return getLogCmd(T("Unable to copy %s: %s", what, err), Error)
+	}
+	return getLogCmd(T("Copied %s to clipboard", what), Info)
+}
+
+func BestAnswer(answers []Answer) (Answer, bool) {
+	if len(answers) == 0 {
+		return Answer{}, false
+	}
+
+	best := answers[0]
+	for _, answer := range answers {
+		if answer.IsAccepted {
+			return answer, true
+		}
+		if answer.Score > best.Score {
+			best = answer
+		}
+	}
+	return best, false
+}
+
+func copyAcceptedCodeCmd(item ResponseItem) tea.Cmd {
+	if len(item.Answers) == 0 {
+		return getLogCmd(T("This question has no answers"), Warning)
+	}
+
+	answer, accepted := BestAnswer(item.Answers)
+
+	code := []string{}
+	for _, segment := range SplitCodeBlocks(answer.BodyMarkdown) {
+		if segment.Code {
+			code = append(code, segment.Text)
+		}
+	}
+
+	what := "accepted answer"
+	if !accepted {
+		what = "top voted answer"
+	}
+	if len(code) == 0 {
+		return getLogCmd(T("The %s has no code blocks", T(what)), Warning)
+	}
+
+	if accepted {
+		return copyCmd(strings.Join(code, "\n\n"), "accepted answer code")
+	}
+	if err := clipboard.WriteAll(strings.Join(code, "\n\n")); err != nil {
+		return getLogCmd(T("Unable to copy %s code: %s", T(what), err), Error)
+	}
+	return getLogCmd(T("No accepted answer, copied the top voted answer's code instead"), Warning)
+}
+
+func copySnippetCmd(item ResponseItem, site string) tea.Cmd {
+	if item.QuestionID == 0 {
+		return getLogCmd(T("No question selected"), Warning)
+	}
+	if len(item.Answers) == 0 {
+		return getLogCmd(T("This question has no answers"), Warning)
+	}
+
+	answer, accepted := BestAnswer(item.Answers)
+	label := "Accepted answer"
+	if !accepted {
+		label = "Top voted answer"
+	}
+
+	snippet := fmt.Sprintf("**[%s](%s)**\n\n%s (score %d):\n\n%s\n", markdownLinkEscaper.Replace(item.DecodedTitle()), item.URL(site), label, answer.Score, strings.TrimSpace(answer.BodyMarkdown))
+	if !accepted {
+		if err := clipboard.WriteAll(snippet); err != nil {
+			return getLogCmd(T("Unable to copy %s: %s", T("snippet"), err), Error)
+		}
+		return getLogCmd(T("No accepted answer, copied a snippet with the top voted answer"), Info)
+	}
+	return copyCmd(snippet, "question and accepted answer")
+}
+
+func copyQuestionCmd(item ResponseItem) tea.Cmd {
+	if item.QuestionID == 0 {
+		return getLogCmd(T("No question selected"), Warning)
+	}
+
+	return copyCmd(fmt.Sprintf("# %s\n\n%s", item.DecodedTitle(), item.BodyMarkdown), "question markdown")
+}
+
+func copyAnswerTextCmd(answer Answer, ok bool) tea.Cmd {
+	if !ok {
+		return getLogCmd(T("This question has no answers"), Warning)
+	}
+
+	return copyCmd(MarkdownToText(answer.BodyMarkdown), "answer as plain text")
+}
+
+func copyMarkdownLinkCmd(item ResponseItem, site string) tea.Cmd {
+	if item.URL(site) == "" {
+		return getLogCmd(T("No question selected"), Warning)
+	}
+
+	return copyCmd(fmt.Sprintf("[%s](%s)", markdownLinkEscaper.Replace(item.DecodedTitle()), item.URL(site)), "markdown link")
+}
@@ -0,0 +1,19 @@
+package main
+
+import "testing"
+
+func TestBestAnswer(t *testing.T) {
+	if answer, accepted := BestAnswer(nil); answer.AnswerID != 0 || accepted {
+		t.Errorf("BestAnswer(nil) = %+v, %v", answer, accepted)
+	}
+
+	answers := []Answer{{AnswerID: 1, Score: 3}, {AnswerID: 2, Score: 9}, {AnswerID: 3, Score: 1}}
+	if answer, accepted := BestAnswer(answers); answer.AnswerID != 2 || accepted {
+		t.Errorf("top voted = %+v, %v", answer, accepted)
+	}
+
+	answers[2].IsAccepted = true
+	if answer, accepted := BestAnswer(answers); answer.AnswerID != 3 || !accepted {
+		t.Errorf("accepted = %+v, %v", answer, accepted)
+	}
+}
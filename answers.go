@@ -0,0 +1,62 @@
+package main
+
+import (
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type answersMsg struct {
+	QuestionID int
+	Answers    []Answer
+	Err        error
+}
+
+func (m *Model) FetchMissingAnswers() tea.Cmd {
+	if len(m.question.Answers) > 0 || m.question.AnswerCount == 0 {
+		return nil
+	}
+
+	id := m.question.QuestionID
+	site := m.QuestionSite()
+
+	go func() {
+		answers, err := GetAnswers(id, site)
+
+		tui.Send(answersMsg{QuestionID: id, Answers: answers, Err: err})
+	}()
+	m.StartWaiting(T("Loading answers..."))
+	return spinner.Tick
+}
+
+func (m *Model) ApplyAnswers(msg answersMsg) tea.Cmd {
+	m.statusMsg = ""
+	m.state = DisplayingQuestionAndAnswers
+
+	if msg.Err != nil {
+		return getLogCmd(T("Unable to load answers: %s", msg.Err), Error)
+	} else if len(msg.Answers) == 0 {
+		return getLogCmd(T("No answers found"), Warning)
+	}
+
+	for i := range m.response.Items {
+		if m.response.Items[i].QuestionID == msg.QuestionID {
+			m.response.Items[i].Answers = msg.Answers
+		}
+	}
+	for page, resp := range m.pages {
+		for i := range resp.Items {
+			if resp.Items[i].QuestionID == msg.QuestionID {
+				m.pages[page].Items[i].Answers = msg.Answers
+			}
+		}
+	}
+
+	if m.question.QuestionID != msg.QuestionID {
+		return nil
+	}
+	m.question.Answers = msg.Answers
+	m.RenderQuestion()
+	m.RestoreScrollPosition()
+
+	return getLogCmd(T("Loaded %d answers", len(msg.Answers)), Info)
+}
@@ -6,6 +6,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"os"
+	"strings"
 
 	"github.com/mitchellh/go-homedir"
 )
@@ -14,7 +15,8 @@ const (
 	baseAuthURL  = "https://stackoverflow.com/oauth/dialog"
 	authClientId = "26062"
 	authKey      = "w1BFZmzoMKahE3t5WYlEBA(("
-	scope        = "no_expiry"
+	readScope    = "no_expiry"
+	writeScope   = "no_expiry,write_access"
 	redirectUri  = "http://localhost:6789/sotui-callback"
 )
 
@@ -61,7 +63,16 @@ func SetToken(access_token string) {
 	token = access_token
 }
 
-func Oauth2() {
+func tokenScopePath() string {
+	return GetDataDir() + "/token_scope"
+}
+
+func HasWriteAccess() bool {
+	data, err := os.ReadFile(tokenScopePath())
+	return err == nil && strings.TrimSpace(string(data)) == writeScope
+}
+
+func Oauth2(scope string) {
 	m := http.NewServeMux()
 	s := http.Server{Addr: ":6789", Handler: m}
 
@@ -87,6 +98,7 @@ func Oauth2() {
 			} else {
 				w.Write([]byte("Authentication successful!"))
 				SetToken(token)
+				os.WriteFile(tokenScopePath(), []byte(scope), 0600)
 				go s.Shutdown(context.Background())
 			}
 		}
@@ -96,6 +108,6 @@ func Oauth2() {
 	s.ListenAndServe()
 }
 
-func GetAuthURL() string {
+func GetAuthURL(scope string) string {
 	return fmt.Sprintf("%s?client_id=%s&scope=%s&redirect_uri=%s", baseAuthURL, authClientId, scope, redirectUri)
 }
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const autoRefreshCheck = 30 * time.Second
+
+type autoRefreshTickMsg struct{}
+
+type autoRefreshMsg struct {
+	Response SEResponse
+	Err      error
+}
+
+func autoRefreshTick() tea.Cmd {
+	return tea.Tick(autoRefreshCheck, func(time.Time) tea.Msg {
+		return autoRefreshTickMsg{}
+	})
+}
+
+func (m Model) AutoRefreshDue() bool {
+	interval := time.Duration(GetConfig().AutoRefreshMinutes) * time.Minute
+	if interval <= 0 || !m.home || m.state != DisplayingAllQuestions || m.bulk != nil || m.filtering {
+		return false
+	}
+	return time.Since(m.lastRefresh) >= interval && time.Since(m.lastActivity) >= autoRefreshCheck
+}
+
+func (m *Model) AutoRefresh() tea.Cmd {
+	if !m.AutoRefreshDue() {
+		return autoRefreshTick()
+	}
+
+	m.lastRefresh = time.Now()
+	site := m.site
+	return tea.Batch(autoRefreshTick(), func() tea.Msg {
+		resp, err := GetHotQuestions(site)
+		return autoRefreshMsg{Response: resp, Err: err}
+	})
+}
+
+func (m *Model) ApplyAutoRefresh(msg autoRefreshMsg) tea.Cmd {
+	if !m.home || m.state != DisplayingAllQuestions || m.bulk != nil || m.filtering {
+		return nil
+	}
+	if msg.Err != nil {
+		return getLogCmd(T("Refreshing hot questions failed: %s", msg.Err), Warning)
+	}
+	if len(msg.Response.Items) == 0 {
+		return nil
+	}
+
+	m.ResetPages(msg.Response)
+	m.response.Items = RankResults(TrimResults(msg.Response.Items, GetConfig().MaxResults))
+	m.response.HasMore = msg.Response.HasMore
+	m.RefreshRows()
+	m.SortResults()
+	return getLogCmd(T("Refreshed hot questions"), Info)
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func OpenURL(url string) error {
+	var cmd *exec.Cmd
+
+	if browser := strings.Fields(GetConfig().Browser); len(browser) > 0 {
+		path, err := exec.LookPath(browser[0])
+		if err != nil {
+			return fmt.Errorf("browser %q not found", browser[0])
+		}
+		return exec.Command(path, append(browser[1:], url)...).Start()
+	}
+
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", url)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+	default:
+		cmd = exec.Command("xdg-open", url)
+	}
+
+	return cmd.Start()
+}
+
+func (m Model) FocusedLink() string {
+	answer, _, ok := m.CurrentAnswer()
+	if !ok || m.viewport.YOffset+m.viewport.Height/2 < m.answerOffsets[0] {
+		return m.question.URL(m.QuestionSite())
+	}
+	return AnswerURL(m.QuestionSite(), m.question.QuestionID, answer.AnswerID)
+}
+
+func openURLCmd(url string) tea.Cmd {
+	if err := OpenURL(url); err != nil {
+		return getLogCmd(T("Unable to open %s: %s", url, err), Error)
+	}
+	return getLogCmd(T("Opened %s", url), Info)
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"github.com/charmbracelet/bubbles/progress"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const (
+	bulkPageSize = 100
+	maxBulkPages = 10
+)
+
+type BulkFetch struct {
+	ID      int
+	Query   Query
+	Site    string
+	Home    bool
+	Fetched int
+	Items   []ResponseItem
+}
+
+type bulkPageMsg struct {
+	ID       int
+	Response SEResponse
+	Err      error
+}
+
+func (bulk BulkFetch) FetchCmd() tea.Cmd {
+	page := bulk.Fetched + 1
+	return func() tea.Msg {
+		var resp SEResponse
+		var err error
+		if bulk.Home {
+			resp, err = GetHotQuestionsPage(bulk.Site, page, bulkPageSize)
+		} else {
+			resp, err = SearchAdvancedPage(bulk.Query, bulk.Site, page, bulkPageSize)
+		}
+		return bulkPageMsg{ID: bulk.ID, Response: resp, Err: err}
+	}
+}
+
+func (m *Model) StartBulkFetch() tea.Cmd {
+	if m.bulk != nil {
+		return nil
+	}
+
+	query, _ := ParseQuery(m.query)
+	if !m.home && !query.IsAdvanced() {
+		return getLogCmd(T("Only tag or quoted searches and hot questions can load more pages"), Warning)
+	}
+
+	m.bulkID++
+	m.bulk = &BulkFetch{ID: m.bulkID, Query: query, Site: m.site, Home: m.home}
+	return m.bulk.FetchCmd()
+}
+
+func (m *Model) CancelBulkFetch() tea.Cmd {
+	fetched := m.bulk.Fetched
+	m.bulk = nil
+	return getLogCmd(T("Stopped loading after %d pages", fetched), Info)
+}
+
+func (m *Model) ApplyBulkPage(msg bulkPageMsg) tea.Cmd {
+	if m.bulk == nil || m.bulk.ID != msg.ID {
+		return nil
+	}
+
+	if msg.Err != nil {
+		fetched := m.bulk.Fetched
+		m.bulk = nil
+		return getLogCmd(T("Loading page %d failed: %s", fetched+1, msg.Err), Error)
+	}
+
+	m.bulk.Fetched++
+	m.bulk.Items = append(m.bulk.Items, msg.Response.Items...)
+
+	if msg.Response.HasMore && m.bulk.Fetched < maxBulkPages {
+		return m.bulk.FetchCmd()
+	}
+
+	items := DedupeResults(m.bulk.Items, map[string]bool{})
+
+	fetched := m.bulk.Fetched
+	m.bulk = nil
+	m.pages = nil
+	m.response.Items = RankResults(TrimResults(items, GetConfig().MaxResults))
+	m.RefreshRows()
+	m.SortResults()
+	return getLogCmd(T("Loaded %d results from %d pages", len(m.response.Items), fetched), Info)
+}
+
+func (m Model) BulkProgressView() string {
+	percent := float64(m.bulk.Fetched) / float64(maxBulkPages)
+	return m.progress.ViewAs(percent) + " " + FadedStyle.Render(T("page %d of up to %d · esc to stop", m.bulk.Fetched, maxBulkPages))
+}
+
+func newProgress() progress.Model {
+	return progress.New(progress.WithSolidFill(string(palette.Accent)), progress.WithWidth(40))
+}
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const cacheDirName = "sotui"
+
+func CacheDir() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, cacheDirName), nil
+}
+
+// cacheKey hashes the request URL without the credentials, so cached
+// responses do not change name when the app key is rotated.
+func cacheKey(rawURL string) string {
+	if parsed, err := url.Parse(rawURL); err == nil {
+		query := parsed.Query()
+		for _, param := range redactedParams {
+			query.Del(param)
+		}
+		parsed.RawQuery = query.Encode()
+		rawURL = parsed.String()
+	}
+
+	sum := sha256.Sum256([]byte(rawURL))
+	return hex.EncodeToString(sum[:]) + ".json"
+}
+
+func cacheTTL() time.Duration {
+	return time.Duration(GetConfig().CacheMinutes) * time.Minute
+}
+
+// Authenticated requests can see content that anonymous ones cannot,
+// so only anonymous requests are cached.
+func cacheEnabled() bool {
+	return cacheTTL() > 0 && GetToken() == ""
+}
+
+func ReadCachedResponse(rawURL string) ([]byte, bool) {
+	if !cacheEnabled() {
+		return nil, false
+	}
+
+	dir, err := CacheDir()
+	if err != nil {
+		return nil, false
+	}
+
+	path := filepath.Join(dir, cacheKey(rawURL))
+	info, err := os.Stat(path)
+	if err != nil || time.Since(info.ModTime()) > cacheTTL() {
+		return nil, false
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+	return data, true
+}
+
+func WriteCachedResponse(rawURL string, data []byte) error {
+	if !cacheEnabled() {
+		return nil
+	}
+
+	dir, err := CacheDir()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+
+	return WriteFileAtomic(filepath.Join(dir, cacheKey(rawURL)), data)
+}
+
+func ClearCache() (int64, error) {
+	dir, err := CacheDir()
+	if err != nil {
+		return 0, err
+	}
+	if filepath.Base(dir) != cacheDirName {
+		return 0, fmt.Errorf("refusing to remove %s", dir)
+	}
+
+	var size int64
+	err = filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if info, err := entry.Info(); err == nil && info.Mode().IsRegular() {
+			size += info.Size()
+		}
+		return nil
+	})
+	if os.IsNotExist(err) {
+		return 0, nil
+	} else if err != nil {
+		return 0, err
+	}
+
+	return size, os.RemoveAll(dir)
+}
+
+func FormatBytes(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+
+	div, exp := int64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(size)/float64(div), "KMGTPE"[exp])
+}
+
+func (m *Model) ConfirmClearCache() tea.Cmd {
+	dir, err := CacheDir()
+	if err != nil {
+		return getLogCmd(T("Unable to find the cache directory: %s", err), Error)
+	}
+
+	return m.ConfirmDestructive(T("Delete everything in %s?", dir), func(m *Model) tea.Cmd {
+		return clearCacheCmd()
+	})
+}
+
+func clearCacheCmd() tea.Cmd {
+	size, err := ClearCache()
+	if err != nil {
+		return getLogCmd(T("Unable to clear the cache: %s", err), Error)
+	}
+	return getLogCmd(T("Cleared the cache, freed %s", FormatBytes(size)), Info)
+}
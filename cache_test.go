@@ -0,0 +1,60 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestResponseCache(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	const rawURL = "https://api.stackexchange.com/2.3/search/advanced?q=go&key=secret"
+
+	if _, ok := ReadCachedResponse(rawURL); ok {
+		t.Fatal("read a response before anything was cached")
+	}
+	if err := WriteCachedResponse(rawURL, []byte(`{"items":[]}`)); err != nil {
+		t.Fatal(err)
+	}
+	if data, ok := ReadCachedResponse(rawURL); !ok || string(data) != `{"items":[]}` {
+		t.Errorf("cached = %q, %v", data, ok)
+	}
+	if _, ok := ReadCachedResponse("https://api.stackexchange.com/2.3/search/advanced?q=go&key=rotated"); !ok {
+		t.Error("cache key depends on the app key")
+	}
+
+	dir, _ := CacheDir()
+	stale := time.Now().Add(-cacheTTL() - time.Minute)
+	os.Chtimes(filepath.Join(dir, cacheKey(rawURL)), stale, stale)
+	if _, ok := ReadCachedResponse(rawURL); ok {
+		t.Error("read an expired response")
+	}
+}
+
+func TestClearCache(t *testing.T) {
+	root := t.TempDir()
+	t.Setenv("XDG_CACHE_HOME", root)
+
+	sibling := filepath.Join(root, "other")
+	os.Mkdir(sibling, 0700)
+	if err := WriteCachedResponse("https://api.stackexchange.com/2.3/questions/1", make([]byte, 100)); err != nil {
+		t.Fatal(err)
+	}
+
+	size, err := ClearCache()
+	if err != nil || size != 100 {
+		t.Errorf("ClearCache() = %d, %v; want 100, nil", size, err)
+	}
+	dir, _ := CacheDir()
+	if _, err := os.Stat(dir); !os.IsNotExist(err) {
+		t.Errorf("cache directory still exists: %v", err)
+	}
+	if _, err := os.Stat(sibling); err != nil {
+		t.Errorf("removed a directory outside the cache: %v", err)
+	}
+
+	if size, err := ClearCache(); err != nil || size != 0 {
+		t.Errorf("second ClearCache() = %d, %v; want 0, nil", size, err)
+	}
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	siteSlugPattern = regexp.MustCompile(`^[a-z0-9]+([.-][a-z0-9]+)*$`)
+	tagPattern      = regexp.MustCompile(`^[a-z0-9#+.-]+$`)
+)
+
+type CLIOptions struct {
+	Site  string
+	Tags  []string
+	Query string
+}
+
+func ParseCLIOptions(site string, tags string, query string, args []string) (CLIOptions, error) {
+	options := CLIOptions{Site: strings.TrimSpace(site)}
+	if options.Site != "" && !siteSlugPattern.MatchString(options.Site) {
+		return options, fmt.Errorf("invalid site %q, expected a slug like stackoverflow or superuser", options.Site)
+	}
+
+	for _, tag := range strings.Split(tags, ",") {
+		if tag = strings.TrimSpace(tag); tag == "" {
+			continue
+		}
+		if !tagPattern.MatchString(tag) {
+			return options, fmt.Errorf("invalid tag %q, expected lowercase letters, digits and #+.-", tag)
+		}
+		options.Tags = append(options.Tags, tag)
+	}
+
+	if query == "" {
+		query = strings.Join(args, " ")
+	}
+	options.Query = strings.TrimSpace(query)
+	for _, tag := range options.Tags {
+		options.Query = strings.TrimSpace(options.Query + " [" + tag + "]")
+	}
+	return options, nil
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const commandPrefix = ":"
+
+var commands = map[string]func(m *Model, args string) tea.Cmd{
+	"sites": func(m *Model, args string) tea.Cmd {
+		m.ShowSitePicker()
+		return nil
+	},
+	"help": func(m *Model, args string) tea.Cmd {
+		m.ShowHelp()
+		return nil
+	},
+	"share": func(m *Model, args string) tea.Cmd {
+		return m.ShareSession(args)
+	},
+	"saved": func(m *Model, args string) tea.Cmd {
+		return m.ShowSavedAnswers()
+	},
+	"helpful": func(m *Model, args string) tea.Cmd {
+		return m.ShowHelpfulAnswers()
+	},
+	"multi": func(m *Model, args string) tea.Cmd {
+		return m.StartMultiSiteSearch(args)
+	},
+	"recent": func(m *Model, args string) tea.Cmd {
+		return m.ShowRecentQuestions()
+	},
+	"settings": func(m *Model, args string) tea.Cmd {
+		m.ShowSettings()
+		return nil
+	},
+	"raw": func(m *Model, args string) tea.Cmd {
+		return m.ShowRawExchange()
+	},
+	"clear-cache": func(m *Model, args string) tea.Cmd {
+		return m.ConfirmClearCache()
+	},
+	"retry": func(m *Model, args string) tea.Cmd {
+		return m.RetryLastRequest()
+	},
+	"login": func(m *Model, args string) tea.Cmd {
+		return Login(readScope)
+	},
+}
+
+func (m *Model) RunCommand(input string) tea.Cmd {
+	name, args, _ := strings.Cut(strings.TrimPrefix(input, commandPrefix), " ")
+
+	command, ok := commands[name]
+	if !ok {
+		return getLogCmd(T("Unknown command: %s", name), Error)
+	}
+
+	m.textarea.Reset()
+	return command(m, strings.TrimSpace(args))
+}
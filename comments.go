@@ -0,0 +1,89 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+type questionCommentsMsg struct {
+	Key      string
+	Comments []Comment
+	Err      error
+}
+
+func (m Model) QuestionKey() string {
+	return fmt.Sprintf("%s/%d", m.QuestionSite(), m.question.QuestionID)
+}
+
+func (m *Model) ToggleQuestionComments() tea.Cmd {
+	if m.hideQuestion {
+		return getLogCmd(T("Show the question first to see its comments"), Warning)
+	}
+
+	m.showComments = !m.showComments
+	m.RenderKeepingAnswer()
+	if !m.showComments {
+		return nil
+	}
+
+	key := m.QuestionKey()
+	if _, ok := m.questionComments[key]; ok {
+		return nil
+	}
+
+	id := m.question.QuestionID
+	site := m.QuestionSite()
+	go func() {
+		comments, err := GetQuestionComments(id, site)
+
+		tui.Send(questionCommentsMsg{Key: key, Comments: comments, Err: err})
+	}()
+	return getLogCmd(T("Loading comments..."), Info)
+}
+
+func (m *Model) ApplyQuestionComments(msg questionCommentsMsg) tea.Cmd {
+	if msg.Err != nil {
+		if msg.Key == m.QuestionKey() {
+			m.showComments = false
+			m.RenderKeepingAnswer()
+		}
+		return getLogCmd(T("Unable to load comments: %s", msg.Err), Error)
+	}
+
+	m.questionComments[msg.Key] = msg.Comments
+	if msg.Key == m.QuestionKey() && m.showComments && m.state == DisplayingQuestionAndAnswers {
+		m.RenderKeepingAnswer()
+	}
+	return nil
+}
+
+func (m Model) QuestionCommentsView(width int) string {
+	style := lipgloss.NewStyle().
+		MarginLeft(2).
+		PaddingLeft(1).
+		Width(width - 4).
+		BorderLeft(true).
+		BorderStyle(lipgloss.NormalBorder()).
+		BorderForeground(palette.Faded)
+
+	comments, ok := m.questionComments[m.QuestionKey()]
+	if !ok {
+		return "\n" + style.Render(FadedStyle.Render(T("Loading comments..."))) + "\n"
+	} else if len(comments) == 0 {
+		return "\n" + style.Render(FadedStyle.Render(T("No comments on this question"))) + "\n"
+	}
+
+	count := T("%d comments", len(comments))
+	if len(comments) == 1 {
+		count = T("1 comment")
+	}
+
+	lines := []string{FadedStyle.Render(count)}
+	for _, comment := range comments {
+		lines = append(lines, "", strings.TrimSpace(comment.Body)+" "+FadedStyle.Render(T("— %s · score %d", comment.Owner.Name(), comment.Score)))
+	}
+	return "\n" + style.Render(strings.Join(lines, "\n")) + "\n"
+}
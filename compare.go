@@ -0,0 +1,93 @@
+package main
+
+import (
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const minCompareWidth = 80
+
+func (m *Model) ToggleCompareMark() tea.Cmd {
+	answer, index, ok := m.CurrentAnswer()
+	if !ok {
+		return getLogCmd(T("No answer in view"), Warning)
+	}
+
+	for i, id := range m.markedAnswers {
+		if id == answer.AnswerID {
+			m.markedAnswers = append(m.markedAnswers[:i], m.markedAnswers[i+1:]...)
+			m.RenderQuestion()
+			return getLogCmd(T("Unmarked answer %d", index+1), Info)
+		}
+	}
+
+	if len(m.markedAnswers) == 2 {
+		m.markedAnswers = m.markedAnswers[1:]
+	}
+	m.markedAnswers = append(m.markedAnswers, answer.AnswerID)
+	m.RenderQuestion()
+
+	return getLogCmd(T("Marked answer %d for comparison (%d/2)", index+1, len(m.markedAnswers)), Info)
+}
+
+func (m Model) IsMarked(answer Answer) bool {
+	for _, id := range m.markedAnswers {
+		if id == answer.AnswerID {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *Model) ShowComparison() tea.Cmd {
+	if len(m.markedAnswers) != 2 {
+		return getLogCmd(T("Mark two answers with m to compare them"), Warning)
+	}
+	if m.width < minCompareWidth {
+		return getLogCmd(T("Comparison needs a terminal at least %d columns wide", minCompareWidth), Warning)
+	}
+
+	paneWidth := m.viewport.Width/2 - 1
+	panes := []viewport.Model{}
+
+	for _, id := range m.markedAnswers {
+		for _, answer := range m.question.Answers {
+			if answer.AnswerID != id {
+				continue
+			}
+
+			pane := viewport.New(paneWidth, m.viewport.Height)
+			pane.MouseWheelEnabled = true
+			pane.SetContent(answerHeader(answer, m.answerMarks[answer.AnswerID]) + "\n" + RenderMarkdown(answer.BodyMarkdown, m.theme, paneWidth))
+			panes = append(panes, pane)
+		}
+	}
+
+	if len(panes) != 2 {
+		return getLogCmd(T("Marked answers are no longer available"), Warning)
+	}
+
+	m.comparePanes = panes
+	m.state = ComparingAnswers
+	return nil
+}
+
+func (m *Model) UpdateComparison(msg tea.Msg) tea.Cmd {
+	cmds := []tea.Cmd{}
+	for i := range m.comparePanes {
+		var cmd tea.Cmd
+		m.comparePanes[i], cmd = m.comparePanes[i].Update(msg)
+		cmds = append(cmds, cmd)
+	}
+	return tea.Batch(cmds...)
+}
+
+func (m Model) ComparisonView() string {
+	if len(m.comparePanes) != 2 {
+		return ""
+	}
+
+	divider := FadedStyle.Render(lipgloss.NewStyle().Height(m.viewport.Height).Render("│"))
+	return lipgloss.JoinHorizontal(lipgloss.Top, m.comparePanes[0].View(), " ", divider, m.comparePanes[1].View())
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/mitchellh/go-homedir"
+)
+
+type SearchFallback struct {
+	Site string `json:"site"`
+	Sort string `json:"sort"`
+}
+
+type Config struct {
+	Mouse               string           `json:"mouse"`
+	OutdatedAnswerYears int              `json:"outdated_answer_years"`
+	FilterTags          bool             `json:"filter_tags"`
+	WrapWidth           int              `json:"wrap_width"`
+	Border              string           `json:"border"`
+	DefaultSite         string           `json:"default_site"`
+	FavoriteSites       []string         `json:"favorite_sites"`
+	PersistErrors       bool             `json:"persist_errors"`
+	ShowTrending        bool             `json:"show_trending"`
+	AnswerLimit         int              `json:"answer_limit"`
+	Theme               string           `json:"theme"`
+	UserAgent           string           `json:"user_agent"`
+	Density             string           `json:"density"`
+	SearchFallbacks     []SearchFallback `json:"search_fallbacks"`
+	AnswerSort          string           `json:"answer_sort"`
+	GistToken           string           `json:"gist_token"`
+	HideQuestionKey     string           `json:"hide_question_key"`
+	SubmitKey           string           `json:"submit_key"`
+	Placeholder         string           `json:"placeholder"`
+	SearchingText       string           `json:"searching_text"`
+	Locale              string           `json:"locale"`
+	MaxResults          int              `json:"max_results"`
+	Appearance          string           `json:"appearance"`
+	ConfirmDestructive  bool             `json:"confirm_destructive"`
+	TitleRatio          float32          `json:"title_ratio"`
+	Renderer            string           `json:"renderer"`
+	Browser             string           `json:"browser"`
+	CodeWrapWidth       int              `json:"code_wrap_width"`
+	WrapNavigation      bool             `json:"wrap_navigation"`
+	APIFilter           string           `json:"api_filter"`
+	Debug               bool             `json:"debug"`
+	RankingWeight       float64          `json:"ranking_weight"`
+	RelaxEmptyQueries   bool             `json:"relax_empty_queries"`
+	LogPosition         string           `json:"log_position"`
+	AutoRefreshMinutes  int              `json:"auto_refresh_minutes"`
+	RenderMath          bool             `json:"render_math"`
+	ShowKeyHints        bool             `json:"show_key_hints"`
+	SelectionColor      string           `json:"selection_color"`
+	EnterAction         string           `json:"enter_action"`
+	CacheMinutes        int              `json:"cache_minutes"`
+}
+
+var config *Config
+
+func defaultConfig() *Config {
+	return &Config{
+		Mouse:               "full",
+		OutdatedAnswerYears: 5,
+		Border:              "rounded",
+		DefaultSite:         defaultSite,
+		FavoriteSites:       []string{"stackoverflow", "superuser", "serverfault", "askubuntu"},
+		PersistErrors:       true,
+		AnswerLimit:         20,
+		Theme:               "auto",
+		Density:             "comfortable",
+		AnswerSort:          "accepted",
+		HideQuestionKey:     "h",
+		SubmitKey:           "enter",
+		MaxResults:          500,
+		Appearance:          "auto",
+		ConfirmDestructive:  true,
+		TitleRatio:          0.6,
+		LogPosition:         "bottom-right",
+		ShowKeyHints:        true,
+		Renderer:            "glamour",
+		EnterAction:         "view",
+		CacheMinutes:        60,
+	}
+}
+
+func GetDataDir() string {
+	dir, err := homedir.Dir()
+	if err != nil {
+		panic("Unable to get home directory")
+	}
+
+	return dir + "/.sotui"
+}
+
+func ConfigExists() bool {
+	_, err := os.Stat(GetDataDir() + "/config.json")
+	return err == nil
+}
+
+func GetConfig() *Config {
+	if config != nil {
+		return config
+	}
+
+	config = defaultConfig()
+
+	data, err := os.ReadFile(GetDataDir() + "/config.json")
+	if err != nil {
+		return config
+	}
+
+	if err := json.Unmarshal(data, config); err != nil {
+		config = defaultConfig()
+	}
+
+	return config
+}
+
+func SaveConfig() error {
+	dir := GetDataDir()
+
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		os.Mkdir(dir, 0700)
+	}
+
+	data, err := json.MarshalIndent(GetConfig(), "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return WriteFileAtomic(dir+"/config.json", data)
+}
@@ -0,0 +1,28 @@
+package main
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type Confirmation struct {
+	Prompt    string
+	OnConfirm func(m *Model) tea.Cmd
+}
+
+func (m *Model) Confirm(prompt string, onConfirm func(m *Model) tea.Cmd) {
+	m.confirmation = &Confirmation{Prompt: prompt, OnConfirm: onConfirm}
+}
+
+func (m Model) UpdateConfirmation(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	confirmation := m.confirmation
+	m.confirmation = nil
+
+	if msg.String() == "y" || msg.String() == "Y" {
+		return m, confirmation.OnConfirm(&m)
+	}
+	return m, getLogCmd(T("Cancelled"), Info)
+}
+
+func (m Model) ConfirmationView() string {
+	return WarningLogStyle.Copy().Padding(0, 1).Render(m.confirmation.Prompt + T(" (y/n)"))
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+	"sync"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+var redactedParams = []string{"key", "access_token"}
+
+type Exchange struct {
+	URL      string
+	Response []byte
+}
+
+var (
+	lastExchange   Exchange
+	lastExchangeMu sync.Mutex
+)
+
+func RedactURL(rawURL string) string {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+
+	query := parsed.Query()
+	for _, param := range redactedParams {
+		if query.Get(param) != "" {
+			query.Set(param, "REDACTED")
+		}
+	}
+	parsed.RawQuery = query.Encode()
+	return parsed.String()
+}
+
+func RecordExchange(rawURL string, response []byte) {
+	if !GetConfig().Debug {
+		return
+	}
+
+	lastExchangeMu.Lock()
+	defer lastExchangeMu.Unlock()
+	lastExchange = Exchange{URL: RedactURL(rawURL), Response: response}
+}
+
+func (m *Model) ShowRawExchange() tea.Cmd {
+	if !GetConfig().Debug {
+		return getLogCmd(T("Set debug to true in the config to record API requests"), Warning)
+	}
+
+	lastExchangeMu.Lock()
+	exchange := lastExchange
+	lastExchangeMu.Unlock()
+
+	if exchange.URL == "" {
+		return getLogCmd(T("No API request recorded yet"), Warning)
+	}
+
+	var pretty bytes.Buffer
+	if err := json.Indent(&pretty, exchange.Response, "", "  "); err != nil {
+		pretty.Reset()
+		pretty.Write(exchange.Response)
+	}
+
+	m.ShowPage(AccentStyle.Render("GET "+exchange.URL)+"\n\n"+pretty.String(), DisplayingRawResponse)
+	return nil
+}
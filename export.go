@@ -0,0 +1,148 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const gistsURL = "https://api.github.com/gists"
+
+type SessionBundle struct {
+	Query    string         `json:"query"`
+	Site     string         `json:"site"`
+	Results  []ResponseItem `json:"results"`
+	Question *ResponseItem  `json:"question,omitempty"`
+}
+
+func (m Model) Bundle() SessionBundle {
+	bundle := SessionBundle{Query: m.query, Site: m.site, Results: m.response.Items}
+	if m.question.QuestionID != 0 {
+		question := m.question
+		bundle.Question = &question
+	}
+	return bundle
+}
+
+func (bundle SessionBundle) Markdown() string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "# sotui session: %s\n\n", bundle.Query)
+	fmt.Fprintf(&sb, "Site: %s\n\n## Results\n\n", bundle.Site)
+	for _, item := range bundle.Results {
+		fmt.Fprintf(&sb, "- [%s](%s) (score %d, %d answers)\n", markdownLinkEscaper.Replace(item.DecodedTitle()), item.URL(bundle.Site), item.Score, item.AnswerCount)
+	}
+
+	if bundle.Question != nil {
+		question := bundle.Question
+		site := bundle.Site
+		if question.Site != "" {
+			site = question.Site
+		}
+		fmt.Fprintf(&sb, "\n## [%s](%s)\n\n%s\n", markdownLinkEscaper.Replace(question.DecodedTitle()), question.URL(bundle.Site), question.BodyMarkdown)
+
+		for _, answer := range question.Answers {
+			accepted := ""
+			if answer.IsAccepted {
+				accepted = ", accepted"
+			}
+			fmt.Fprintf(&sb, "\n---\n\n### [Answer %d](%s) (score %d%s)\n\n%s\n", answer.AnswerID, AnswerURL(site, question.QuestionID, answer.AnswerID), answer.Score, accepted, answer.BodyMarkdown)
+		}
+	}
+
+	return sb.String()
+}
+
+func (bundle SessionBundle) Encode(format string) (string, error) {
+	if format == "json" {
+		data, err := json.MarshalIndent(bundle, "", "  ")
+		return string(data), err
+	}
+	return bundle.Markdown(), nil
+}
+
+func WriteBundle(content string, format string) (string, error) {
+	dir := GetDataDir() + "/exports"
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", err
+	}
+
+	path := fmt.Sprintf("%s/session-%s.%s", dir, time.Now().Format("20060102-150405"), format)
+	return path, os.WriteFile(path, []byte(content), 0600)
+}
+
+func UploadGist(content string, format string, token string) (string, error) {
+	payload, _ := json.Marshal(map[string]interface{}{
+		"description": "sotui session",
+		"public":      false,
+		"files": map[string]interface{}{
+			"session." + format: map[string]string{"content": content},
+		},
+	})
+
+	req, _ := http.NewRequest("POST", gistsURL, bytes.NewReader(payload))
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("User-Agent", UserAgent())
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	respBytes, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusCreated {
+		return "", fmt.Errorf("github returned %s", resp.Status)
+	}
+
+	gist := struct {
+		HTMLURL string `json:"html_url"`
+	}{}
+	if err := json.Unmarshal(respBytes, &gist); err != nil {
+		return "", err
+	}
+	return gist.HTMLURL, nil
+}
+
+func (m Model) ShareSession(format string) tea.Cmd {
+	if format != "json" {
+		format = "md"
+	}
+	if m.query == "" && m.question.QuestionID == 0 {
+		return getLogCmd(T("Nothing to share yet"), Warning)
+	}
+
+	content, err := m.Bundle().Encode(format)
+	if err != nil {
+		return getLogCmd(T("Unable to encode session: %s", err), Error)
+	}
+
+	token := GetConfig().GistToken
+	go func() {
+		if token != "" {
+			url, err := UploadGist(content, format, token)
+			if err == nil {
+				tui.Send(logMsg{Msg: T("Shared session: %s", url), Type: Info})
+				return
+			}
+			tui.Send(logMsg{Msg: T("Unable to upload gist: %s", err), Type: Error})
+		}
+
+		path, err := WriteBundle(content, format)
+		if err != nil {
+			tui.Send(logMsg{Msg: T("Unable to export session: %s", err), Type: Error})
+			return
+		}
+		tui.Send(logMsg{Msg: T("Exported session to %s", path), Type: Info})
+	}()
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"regexp"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func (m *Model) StartFilter() {
+	m.filtering = true
+	m.filter.SetValue("")
+	m.filter.Focus()
+	m.table.Blur()
+}
+
+func (m *Model) StopFilter() {
+	m.filtering = false
+	m.filter.Blur()
+	m.table.Focus()
+}
+
+func (m *Model) ClearFilter() {
+	m.filter.SetValue("")
+	m.filterInvalid = false
+	m.RefreshRows()
+	m.table.GotoTop()
+}
+
+const bodyFilterPrefix = "~"
+
+func (m Model) FilterPattern() (string, bool) {
+	pattern := m.filter.Value()
+	if strings.HasPrefix(pattern, bodyFilterPrefix) {
+		return strings.TrimPrefix(pattern, bodyFilterPrefix), true
+	}
+	return pattern, false
+}
+
+func (m Model) FilterMatcher() (func(string) bool, bool) {
+	pattern, _ := m.FilterPattern()
+
+	re, err := regexp.Compile("(?i)" + pattern)
+	if err != nil {
+		return func(s string) bool {
+			return strings.Contains(strings.ToLower(s), strings.ToLower(pattern))
+		}, false
+	}
+	return re.MatchString, true
+}
+
+func (m Model) FilteredResponse() SEResponse {
+	pattern, matchBody := m.FilterPattern()
+	if pattern == "" {
+		return m.response
+	}
+
+	match, _ := m.FilterMatcher()
+	filtered := SEResponse{}
+	for _, item := range m.response.Items {
+		if match(item.DecodedTitle()) ||
+			(GetConfig().FilterTags && match(strings.Join(item.Tags, " "))) ||
+			(matchBody && match(item.BodyMarkdown)) {
+			filtered.Items = append(filtered.Items, item)
+		}
+	}
+	return filtered
+}
+
+func (m *Model) RefreshRows() {
+	m.table.SetRows(m.FilteredResponse().ToRows(m.ColumnWidths()))
+}
+
+func (m *Model) ApplyFilter() tea.Cmd {
+	var cmd tea.Cmd
+
+	_, valid := m.FilterMatcher()
+	if !valid && !m.filterInvalid {
+		cmd = getLogCmd(T("Invalid regex, falling back to substring match"), Warning)
+	}
+	m.filterInvalid = !valid
+
+	m.RefreshRows()
+	m.table.GotoTop()
+	return cmd
+}
+
+func (m Model) UpdateFilter(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyCtrlC:
+		return m, tea.Quit
+	case tea.KeyEnter:
+		m.StopFilter()
+		return m, nil
+	case tea.KeyEsc:
+		m.StopFilter()
+		m.ClearFilter()
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.filter, cmd = m.filter.Update(msg)
+
+	return m, tea.Batch(cmd, m.ApplyFilter())
+}
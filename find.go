@@ -0,0 +1,112 @@
+package main
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+var ansiSequence = regexp.MustCompile("\x1b\\[[0-9;]*[a-zA-Z]")
+
+func FindPattern(text string) *regexp.Regexp {
+	if strings.TrimSpace(text) == "" {
+		return nil
+	}
+	return regexp.MustCompile(`(?i)(` + regexp.QuoteMeta(text) + `)`)
+}
+
+func MatchingLines(content string, pattern *regexp.Regexp) []int {
+	if pattern == nil {
+		return nil
+	}
+
+	lines := []int{}
+	for i, line := range strings.Split(content, "\n") {
+		if pattern.MatchString(ansiSequence.ReplaceAllString(line, "")) {
+			lines = append(lines, i)
+		}
+	}
+	return lines
+}
+
+func (m *Model) StartFind() tea.Cmd {
+	if len(m.renderedAnswers) < len(m.question.Answers) || m.scoreFilter {
+		m.showAllAnswers = true
+		m.acceptedOnly = false
+		m.scoreFilter = false
+		m.RenderQuestion()
+	}
+
+	m.finding = true
+	m.find.SetValue("")
+	m.find.Focus()
+	return textinput.Blink
+}
+
+func (m *Model) ClearFind() {
+	m.finding = false
+	m.find.Blur()
+	m.find.SetValue("")
+	m.findPattern = nil
+	m.findMatches = nil
+	m.findIndex = 0
+}
+
+func (m *Model) ApplyFind() {
+	m.findPattern = FindPattern(m.find.Value())
+	m.findIndex = 0
+	m.RenderQuestion()
+	m.GotoMatch(0)
+}
+
+func (m *Model) GotoMatch(delta int) tea.Cmd {
+	if len(m.findMatches) == 0 {
+		if m.findPattern == nil {
+			return nil
+		}
+		return getLogCmd(T("No matches for %q", m.find.Value()), Warning)
+	}
+
+	m.findIndex = (m.findIndex + delta + len(m.findMatches)) % len(m.findMatches)
+	offset := m.findMatches[m.findIndex] - 1
+	if offset < 0 {
+		offset = 0
+	}
+	m.viewport.SetYOffset(offset)
+	return getLogCmd(T("Match %d of %d", m.findIndex+1, len(m.findMatches)), Info)
+}
+
+func (m Model) UpdateFind(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyCtrlC:
+		return m, tea.Quit
+	case tea.KeyEnter:
+		m.finding = false
+		m.find.Blur()
+		return m, m.GotoMatch(0)
+	case tea.KeyEsc:
+		m.ClearFind()
+		m.RenderQuestion()
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.find, cmd = m.find.Update(msg)
+	m.ApplyFind()
+
+	return m, cmd
+}
+
+func (m Model) FindView() string {
+	view := m.find.View()
+	if m.findPattern != nil {
+		if len(m.findMatches) == 0 {
+			view += FadedStyle.Render(T("  no matches"))
+		} else {
+			view += FadedStyle.Render(T("  %d/%d · n/N to cycle", m.findIndex+1, len(m.findMatches)))
+		}
+	}
+	return view
+}
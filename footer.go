@@ -0,0 +1,117 @@
+package main
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+var logPositions = []string{"bottom-right", "bottom-left", "bottom", "top-right", "top-left", "top"}
+
+func LogPosition() string {
+	for _, position := range logPositions {
+		if GetConfig().LogPosition == position {
+			return position
+		}
+	}
+	return logPositions[0]
+}
+
+func LogOnTop() bool {
+	return strings.HasPrefix(LogPosition(), "top")
+}
+
+func (m Model) LogView() string {
+	if m.log == nil {
+		return ""
+	}
+
+	style := InfoLogStyle
+	switch m.log.Type {
+	case Warning:
+		style = WarningLogStyle
+	case Error:
+		style = ErrorLogStyle
+	}
+
+	text := m.log.Msg
+	if m.log.Type == Error && GetConfig().PersistErrors {
+		text += T(" (ctrl+x)")
+	}
+
+	style = style.Copy().Padding(0, 1)
+	if position := LogPosition(); position == "top" || position == "bottom" {
+		style = style.Width(m.width).MaxWidth(m.width)
+	}
+	return style.Render(text)
+}
+
+func (m Model) NotificationView() string {
+	if m.confirmation != nil {
+		return m.ConfirmationView()
+	}
+	return m.LogView()
+}
+
+func (m Model) HeaderView() string {
+	if !LogOnTop() {
+		return ""
+	}
+
+	align := lipgloss.Center
+	switch LogPosition() {
+	case "top-left":
+		align = lipgloss.Left
+	case "top-right":
+		align = lipgloss.Right
+	}
+	return lipgloss.PlaceHorizontal(m.width, align, m.NotificationView()) + "\n"
+}
+
+func (m Model) FooterView() string {
+	site := m.site
+	if m.state == DisplayingQuestionAndAnswers {
+		site = m.QuestionSite()
+	}
+	if site == "" {
+		site = defaultSite
+	}
+
+	left := FadedStyle.Render(T("site: ")) + AccentStyle.Render(site)
+	if m.home && m.state == DisplayingAllQuestions {
+		left += FadedStyle.Render(T(" · hot questions"))
+	}
+	if m.state == DisplayingAllQuestions {
+		left += FadedStyle.Render(m.PageView())
+	}
+	if RankingWeight() > 0 && m.sortColumn < 0 && m.state == DisplayingAllQuestions {
+		left += FadedStyle.Render(T(" · ranked"))
+	}
+	if counts := m.SiteCountsView(); counts != "" && m.state == DisplayingAllQuestions {
+		left = FadedStyle.Render(T("sites: ")) + AccentStyle.Render(counts)
+	}
+	right := ""
+	switch LogPosition() {
+	case "bottom-right":
+		right = m.NotificationView()
+	case "bottom-left":
+		if notification := m.NotificationView(); notification != "" {
+			left, right = notification, left
+		}
+	case "bottom":
+		if notification := m.NotificationView(); notification != "" {
+			return notification
+		}
+	}
+
+	if hints := m.KeyHintsView(m.width - lipgloss.Width(left) - lipgloss.Width(right) - 4); hints != "" {
+		left += "  " + hints
+	}
+
+	gap := m.width - lipgloss.Width(left) - lipgloss.Width(right)
+	if gap < 1 {
+		gap = 1
+	}
+
+	return lipgloss.NewStyle().MaxWidth(m.width).Render(left + strings.Repeat(" ", gap) + right)
+}
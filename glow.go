@@ -0,0 +1,84 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+)
+
+type glowKey struct {
+	markdown string
+	theme    string
+	width    int
+}
+
+const maxGlowRenders = 64
+
+var (
+	glowPath     string
+	glowLookedUp bool
+	glowRenders  = map[glowKey]string{}
+	glowOrder    = []glowKey{}
+)
+
+func GlowPath() string {
+	if !glowLookedUp {
+		glowPath, _ = exec.LookPath("glow")
+		glowLookedUp = true
+	}
+	return glowPath
+}
+
+func UseGlow() bool {
+	return GetConfig().Renderer == "glow" && GlowPath() != ""
+}
+
+func glowStyle(theme string) string {
+	switch theme {
+	case "dark", "light", "notty", "dracula", "pink":
+		return theme
+	}
+	return appearance
+}
+
+func RenderWithGlow(markdown string, theme string, width int) (string, error) {
+	key := glowKey{markdown: markdown, theme: theme, width: width}
+	if rendered, ok := glowRenders[key]; ok {
+		touchGlowRender(key)
+		return rendered, nil
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command(GlowPath(), "-s", glowStyle(theme), "-w", fmt.Sprintf("%d", width), "-")
+	cmd.Env = append(os.Environ(), "CLICOLOR_FORCE=1")
+	cmd.Stdin = bytes.NewBufferString(markdown)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("glow: %s %s", err, stderr.String())
+	}
+
+	cacheGlowRender(key, stdout.String())
+	return stdout.String(), nil
+}
+
+func touchGlowRender(key glowKey) {
+	for i, cached := range glowOrder {
+		if cached == key {
+			glowOrder = append(glowOrder[:i], glowOrder[i+1:]...)
+			break
+		}
+	}
+	glowOrder = append(glowOrder, key)
+}
+
+func cacheGlowRender(key glowKey, rendered string) {
+	glowRenders[key] = rendered
+	touchGlowRender(key)
+	for len(glowOrder) > maxGlowRenders {
+		delete(glowRenders, glowOrder[0])
+		glowOrder = glowOrder[1:]
+	}
+}
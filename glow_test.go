@@ -0,0 +1,20 @@
+package main
+
+import "testing"
+
+func TestGlowRenderCacheIsBounded(t *testing.T) {
+	for i := 0; i < maxGlowRenders*2; i++ {
+		cacheGlowRender(glowKey{markdown: "body", width: i}, "rendered")
+		touchGlowRender(glowKey{markdown: "body", width: 0})
+	}
+
+	if len(glowRenders) != maxGlowRenders || len(glowOrder) != maxGlowRenders {
+		t.Errorf("cache has %d renders and %d keys, want %d", len(glowRenders), len(glowOrder), maxGlowRenders)
+	}
+	if _, ok := glowRenders[glowKey{markdown: "body", width: 0}]; !ok {
+		t.Error("recently used render was evicted")
+	}
+	if _, ok := glowRenders[glowKey{markdown: "body", width: 1}]; ok {
+		t.Error("least recently used render was kept")
+	}
+}
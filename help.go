@@ -0,0 +1,116 @@
+package main
+
+const helpMarkdown = "# Help\n" + `
+## Query syntax
+
+| Syntax      | Meaning                                 |
+|-------------|-----------------------------------------|
+| word        | free text search                        |
+| "a phrase"  | the title must contain the phrase       |
+| -word       | exclude results containing the word     |
+| [tag]       | only questions tagged with tag          |
+| -[tag]      | exclude questions tagged with tag       |
+| #12345      | open the question with id 12345         |
+| :command    | run a command (:sites, :retry, :login, :help, :share [json], :saved, :recent, :settings, :raw, :helpful, :multi <query>, :clear-cache) |
+
+Queries that use quotes or tags go through the Stack Exchange advanced search,
+everything else is searched with Google.
+
+## Keys
+
+| Key         | Where          | Action                                  |
+|-------------|----------------|-----------------------------------------|
+| enter       | input          | search (see submit_key below)           |
+| ctrl+n      | anywhere       | switch to the next favorite site        |
+| ctrl+s      | anywhere       | cycle mouse mode (off/wheel/full)       |
+| ctrl+r      | anywhere       | retry the last failed request           |
+| ctrl+z      | anywhere       | suspend to the shell, resume with fg    |
+| ctrl+x      | anywhere       | dismiss the current log                 |
+| ?           | results/view   | show this help                          |
+| /           | results        | filter rows (prefix with ~ for bodies)  |
+| < / >       | results        | narrow / widen the title column         |
+| ctrl+l      | results        | load all pages (esc stops)              |
+| n / p       | results        | next / previous page                    |
+| v           | results        | view the question (see enter_action)    |
+| P           | results        | toggle the preview pane                 |
+| L           | results/view   | copy the question as a markdown link    |
+| O           | results/view   | open the question or answer in browser  |
+| c           | view           | copy the accepted answer's code         |
+| Q           | view           | copy the question as markdown           |
+| Y           | view           | copy the current answer as plain text   |
+| y / x       | view           | mark the answer helpful / not helpful   |
+| S           | view           | copy title, link and accepted answer    |
+| T           | view           | expand or collapse the question's tags  |
+| s           | view           | save or unsave the current answer       |
+| enter / o   | saved answers  | reopen the question / open the answer   |
+| x           | saved answers  | remove the saved answer                 |
+| u           | after removing | undo the removal (for a few seconds)    |
+| D           | results/view   | toggle compact density                  |
+| tab         | view           | jump to the next answer                 |
+| shift+tab   | view           | jump to the previous answer             |
+| J           | view           | jump past the question to the answers   |
+| C           | view           | collapse answers into a summary list    |
+| W           | view           | view the answer's table fullscreen      |
+| g / home    | view           | jump back to the top                    |
+| l           | view           | list links in the current answer        |
+| a           | view           | show all answers                        |
+| A           | view           | toggle showing only the accepted answer |
+| ] / [       | view           | raise / lower the minimum answer score  |
+| p           | view           | toggle hiding code blocks               |
+| #           | view           | toggle line numbers in code blocks      |
+| e           | view           | search again starting from the title    |
+| E           | view           | show / hide the question's comments     |
+| /           | view           | find text in the question and answers   |
+| n / N       | view           | next / previous match                   |
+| M           | view           | toggle plain text for copy and paste    |
+| h           | view           | collapse the question to its title      |
+| H           | view           | toggle highlighting the search terms    |
+| m / =       | view           | mark answers / compare two marked       |
+| v / V       | view           | upvote / downvote the current answer    |
+| r           | view           | refresh answers                         |
+| P           | view           | open the question in $PAGER             |
+| t           | view           | cycle the markdown theme                |
+| + / -       | view           | zoom in / out by reflowing the content  |
+| o           | view           | sort answers by accepted/votes/recent   |
+| backspace   | anywhere       | go back                                 |
+| esc, ctrl+c | anywhere       | quit                                    |
+
+## Multi-line queries
+
+Set ` + "`submit_key`" + ` in the config to ` + "`alt+enter`" + ` or ` + "`ctrl+j`" + ` to compose queries over
+several lines: enter then inserts a newline and the configured key submits.
+`
+
+func (m *Model) ShowHelp() {
+	m.ShowPage(RenderMarkdown(T(helpMarkdown), m.theme, m.ContentWidth()), DisplayingHelpScreen)
+}
+
+func (m *Model) ShowPage(content string, state State) {
+	if m.state != DisplayingHelpScreen && m.state != DisplayingRawResponse {
+		m.helpReturnState = m.state
+	}
+
+	if m.state == DisplayingQuestionAndAnswers {
+		m.SaveScrollPosition()
+	}
+	m.table.Blur()
+	m.textarea.Blur()
+	m.viewport.SetContent(content)
+	m.viewport.GotoTop()
+	m.state = state
+}
+
+func (m *Model) HideHelp() {
+	m.state = m.helpReturnState
+
+	switch m.state {
+	case DisplayingQuestionAndAnswers:
+		m.RenderQuestion()
+		m.RestoreScrollPosition()
+	case DisplayingAllQuestions:
+		m.table.Focus()
+	default:
+		m.state = WaitingForInput
+		m.textarea.Focus()
+	}
+}
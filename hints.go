@@ -0,0 +1,77 @@
+package main
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+type KeyHint struct {
+	Key  string
+	Desc string
+}
+
+func (m Model) KeyHints() []KeyHint {
+	switch m.state {
+	case WaitingForInput:
+		return []KeyHint{{SubmitKey(), "search"}, {":", "commands"}, {"ctrl+n", "next site"}, {"esc", "quit"}}
+	case DisplayingAllQuestions:
+		if m.bulk != nil {
+			return []KeyHint{{"esc", "stop loading"}}
+		}
+		hints := []KeyHint{{"enter", "open"}, {"backspace", "back"}, {"/", "filter"}, {"O", "browser"}, {"n/p", "page"}, {"?", "help"}}
+		switch GetConfig().EnterAction {
+		case "browser":
+			hints[0] = KeyHint{"enter", "browser"}
+			hints[3] = KeyHint{"v", "view"}
+		case "preview":
+			hints[0] = KeyHint{"enter", "preview"}
+			hints[3] = KeyHint{"v", "view"}
+		}
+		return hints
+	case DisplayingQuestionAndAnswers:
+		hints := []KeyHint{{"tab", "next answer"}, {"J", "answers"}, {"c", "copy code"}, {"O", "browser"}, {"s", "save"}, {"e", "refine"}}
+		if m.findPattern != nil {
+			hints = append([]KeyHint{{"n/N", "next match"}}, hints...)
+		}
+		if key := GetConfig().HideQuestionKey; key != "" {
+			hints = append(hints, KeyHint{key, "hide question"})
+		}
+		return append(hints, KeyHint{"backspace", "back"}, KeyHint{"?", "help"})
+	case DisplayingLinks:
+		return []KeyHint{{"enter", "open"}, {"a", "open all"}, {"backspace", "back"}}
+	case DisplayingAnswerSummary:
+		return []KeyHint{{"enter", "jump to answer"}, {"backspace", "back"}}
+	case DisplayingWideTable:
+		return []KeyHint{{"←/→", "scroll"}, {"W", "next table"}, {"backspace", "back"}}
+	case DisplayingSavedAnswers, DisplayingHelpfulAnswers:
+		return []KeyHint{{"enter", "open"}, {"o", "browser"}, {"x", "remove"}, {"backspace", "back"}}
+	case DisplayingRecentQuestions:
+		return []KeyHint{{"enter", "open"}, {"backspace", "back"}}
+	case EditingSettings:
+		return []KeyHint{{"enter", "edit"}, {"w", "save"}, {"backspace", "back"}}
+	case ComparingAnswers, DisplayingHelpScreen, DisplayingRawResponse, DisplayingAllComments:
+		return []KeyHint{{"backspace", "back"}}
+	}
+	return nil
+}
+
+func (m Model) KeyHintsView(width int) string {
+	if !GetConfig().ShowKeyHints {
+		return ""
+	}
+
+	parts := []string{}
+	for _, hint := range m.KeyHints() {
+		parts = append(parts, hint.Key+": "+T(hint.Desc))
+	}
+
+	for len(parts) > 0 {
+		view := strings.Join(parts, " · ")
+		if lipgloss.Width(view) <= width {
+			return FadedStyle.Render(view)
+		}
+		parts = parts[:len(parts)-1]
+	}
+	return ""
+}
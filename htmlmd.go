@@ -0,0 +1,180 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+var (
+	blankLines = regexp.MustCompile(`\n{3,}`)
+	whitespace = regexp.MustCompile(`\s+`)
+)
+
+type htmlConverter struct {
+	sb    strings.Builder
+	lists []int
+	inPre bool
+}
+
+func HTMLToMarkdown(body string) string {
+	root, err := html.Parse(strings.NewReader(body))
+	if err != nil {
+		return html.UnescapeString(body)
+	}
+
+	c := &htmlConverter{}
+	c.children(root)
+	return tidyMarkdown(c.sb.String())
+}
+
+func tidyMarkdown(markdown string) string {
+	lines := strings.Split(markdown, "\n")
+	for i, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			lines[i] = ""
+		} else if !strings.HasPrefix(strings.TrimSpace(line), "```") {
+			lines[i] = strings.TrimRight(line, " ")
+		}
+	}
+	return strings.TrimSpace(blankLines.ReplaceAllString(strings.Join(lines, "\n"), "\n\n"))
+}
+
+func (c *htmlConverter) write(text string) {
+	c.sb.WriteString(text)
+}
+
+func (c *htmlConverter) block() {
+	c.write("\n\n")
+}
+
+func (c *htmlConverter) children(n *html.Node) {
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		c.node(child)
+	}
+}
+
+func (c *htmlConverter) text(n *html.Node) string {
+	inner := &htmlConverter{inPre: c.inPre}
+	inner.children(n)
+	return inner.sb.String()
+}
+
+func attr(n *html.Node, name string) string {
+	for _, a := range n.Attr {
+		if a.Key == name {
+			return a.Val
+		}
+	}
+	return ""
+}
+
+func (c *htmlConverter) node(n *html.Node) {
+	if n.Type == html.TextNode {
+		if c.inPre {
+			c.write(n.Data)
+		} else {
+			c.write(whitespace.ReplaceAllString(n.Data, " "))
+		}
+		return
+	}
+	if n.Type != html.ElementNode {
+		c.children(n)
+		return
+	}
+
+	switch n.DataAtom {
+	case atom.P, atom.Div:
+		c.block()
+		c.children(n)
+		c.block()
+	case atom.Br:
+		c.write("\n")
+	case atom.Hr:
+		c.block()
+		c.write("---")
+		c.block()
+	case atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6:
+		c.block()
+		c.write(strings.Repeat("#", int(n.Data[1]-'0')) + " " + strings.TrimSpace(c.text(n)))
+		c.block()
+	case atom.Strong, atom.B:
+		c.write("**" + strings.TrimSpace(c.text(n)) + "**")
+	case atom.Em, atom.I:
+		c.write("*" + strings.TrimSpace(c.text(n)) + "*")
+	case atom.Del, atom.S, atom.Strike:
+		c.write("~~" + strings.TrimSpace(c.text(n)) + "~~")
+	case atom.Code:
+		if c.inPre {
+			c.children(n)
+		} else {
+			c.write("`" + c.text(n) + "`")
+		}
+	case atom.Pre:
+		c.inPre = true
+		code := strings.TrimRight(c.text(n), "\n")
+		c.inPre = false
+		c.block()
+		c.write("```\n" + code + "\n```")
+		c.block()
+	case atom.A:
+		text := strings.TrimSpace(c.text(n))
+		if href := attr(n, "href"); href != "" {
+			c.write(fmt.Sprintf("[%s](%s)", text, href))
+		} else {
+			c.write(text)
+		}
+	case atom.Img:
+		c.write(fmt.Sprintf("![%s](%s)", attr(n, "alt"), attr(n, "src")))
+	case atom.Blockquote:
+		quoted := strings.Split(tidyMarkdown(c.text(n)), "\n")
+		for i, line := range quoted {
+			quoted[i] = strings.TrimRight("> "+line, " ")
+		}
+		c.block()
+		c.write(strings.Join(quoted, "\n"))
+		c.block()
+	case atom.Ul, atom.Ol:
+		start := 0
+		if n.DataAtom == atom.Ol {
+			start = 1
+		}
+		c.lists = append(c.lists, start)
+		c.write("\n")
+		c.children(n)
+		c.lists = c.lists[:len(c.lists)-1]
+		c.write("\n")
+	case atom.Li:
+		marker := "- "
+		depth := len(c.lists)
+		if depth > 0 && c.lists[depth-1] > 0 {
+			marker = fmt.Sprintf("%d. ", c.lists[depth-1])
+			c.lists[depth-1]++
+		}
+		indent := ""
+		if depth > 1 {
+			indent = strings.Repeat("  ", depth-1)
+		}
+		c.write("\n" + indent + marker + strings.TrimSpace(c.text(n)))
+	default:
+		c.children(n)
+	}
+}
+
+func (response *SEResponse) FillMarkdown() {
+	for i := range response.Items {
+		item := &response.Items[i]
+		if item.BodyMarkdown == "" && item.Body != "" {
+			item.BodyMarkdown = HTMLToMarkdown(item.Body)
+		}
+		for j := range item.Answers {
+			answer := &item.Answers[j]
+			if answer.BodyMarkdown == "" && answer.Body != "" {
+				answer.BodyMarkdown = HTMLToMarkdown(answer.Body)
+			}
+		}
+	}
+}
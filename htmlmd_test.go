@@ -0,0 +1,30 @@
+package main
+
+import "testing"
+
+func TestDecodeResponseFillsMarkdownFromHTML(t *testing.T) {
+	data := `{"items":[{"question_id":1,"title":"t",` +
+		`"body":"<p>Use <code>go build</code> and <strong>check</strong> <a href=\"https://go.dev\">docs</a>.</p>\n<pre><code>x := 1\n</code></pre>\n<ul><li>one</li><li>two</li></ul>",` +
+		`"answers":[{"answer_id":2,"body":"<blockquote><p>quoted</p></blockquote>"}]}]}`
+
+	resp, err := DecodeResponse([]byte(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := "Use `go build` and **check** [docs](https://go.dev).\n\n```\nx := 1\n```\n\n- one\n- two"
+	if got := resp.Items[0].BodyMarkdown; got != want {
+		t.Errorf("question markdown = %q, want %q", got, want)
+	}
+	if got := resp.Items[0].Answers[0].BodyMarkdown; got != "> quoted" {
+		t.Errorf("answer markdown = %q, want %q", got, "> quoted")
+	}
+}
+
+func TestFillMarkdownKeepsBodyMarkdown(t *testing.T) {
+	resp := SEResponse{Items: []ResponseItem{{BodyMarkdown: "original", Body: "<p>html</p>"}}}
+	resp.FillMarkdown()
+	if got := resp.Items[0].BodyMarkdown; got != "original" {
+		t.Errorf("markdown = %q, want %q", got, "original")
+	}
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+)
+
+var (
+	locales     = map[string]map[string]string{}
+	localesLock sync.RWMutex
+)
+
+func Locale() string {
+	locale := GetConfig().Locale
+	if locale == "" {
+		locale = os.Getenv("LANG")
+	}
+
+	locale, _, _ = strings.Cut(locale, ".")
+	locale, _, _ = strings.Cut(locale, "_")
+	if locale == "" || locale == "C" || locale == "POSIX" {
+		return "en"
+	}
+	return strings.ToLower(locale)
+}
+
+func LoadLocale(locale string) map[string]string {
+	localesLock.RLock()
+	catalog, ok := locales[locale]
+	localesLock.RUnlock()
+	if ok {
+		return catalog
+	}
+
+	localesLock.Lock()
+	defer localesLock.Unlock()
+	if catalog, ok := locales[locale]; ok {
+		return catalog
+	}
+
+	catalog = map[string]string{}
+	if data, err := os.ReadFile(fmt.Sprintf("%s/locales/%s.json", GetDataDir(), locale)); err == nil {
+		json.Unmarshal(data, &catalog)
+	}
+	locales[locale] = catalog
+	return catalog
+}
+
+func T(format string, args ...interface{}) string {
+	if translated, ok := LoadLocale(Locale())[format]; ok && translated != "" {
+		format = translated
+	}
+
+	if len(args) == 0 {
+		return format
+	}
+	return fmt.Sprintf(format, args...)
+}
@@ -0,0 +1,274 @@
+package main
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func (m *Model) HandleKey(msg tea.KeyMsg) (tea.Cmd, bool) {
+	switch m.state {
+	case DisplayingAllQuestions:
+		return m.HandleQuestionListKey(msg)
+	case DisplayingQuestionAndAnswers:
+		return m.HandleQuestionViewKey(msg)
+	case DisplayingLinks:
+		return m.HandleLinkListKey(msg)
+	case DisplayingAnswerSummary:
+		return m.HandleAnswerSummaryKey(msg)
+	case DisplayingWideTable:
+		return m.HandleWideTableKey(msg)
+	case ComparingAnswers:
+		if msg.Type == tea.KeyCtrlC || msg.Type == tea.KeyEsc {
+			return nil, false
+		} else if msg.String() == "backspace" {
+			m.comparePanes = nil
+			m.state = DisplayingQuestionAndAnswers
+			return nil, true
+		}
+		return m.UpdateComparison(msg), true
+	case PickingSites:
+		return m.HandleSitePickerKey(msg)
+	case DisplayingSavedAnswers:
+		return m.HandleSavedAnswerKey(msg)
+	case DisplayingHelpfulAnswers:
+		return m.HandleHelpfulAnswerKey(msg)
+	case DisplayingRecentQuestions:
+		return m.HandleRecentQuestionKey(msg)
+	case EditingSettings:
+		return m.HandleSettingsKey(msg)
+	}
+	return nil, false
+}
+
+func (m *Model) WrapTableCursor(msg tea.KeyMsg) bool {
+	rows := len(m.table.Rows())
+	if !GetConfig().WrapNavigation || rows == 0 {
+		return false
+	}
+
+	switch {
+	case key.Matches(msg, m.table.KeyMap.LineUp) && m.table.Cursor() == 0:
+		m.table.GotoBottom()
+		return true
+	case key.Matches(msg, m.table.KeyMap.LineDown) && m.table.Cursor() == rows-1:
+		m.table.GotoTop()
+		return true
+	}
+	return false
+}
+
+func (m *Model) HandleQuestionListKey(msg tea.KeyMsg) (tea.Cmd, bool) {
+	switch msg.String() {
+	case "?":
+		m.ShowHelp()
+		return nil, true
+	case "/":
+		m.StartFilter()
+		return textinput.Blink, true
+	case "L":
+		item, _ := m.SelectedItem()
+		return copyMarkdownLinkCmd(item, m.site), true
+	case "O":
+		if item, ok := m.SelectedItem(); ok {
+			return openURLCmd(item.URL(m.site)), true
+		}
+		return getLogCmd(T("No question selected"), Warning), true
+	case "D":
+		return m.ToggleDensity(), true
+	case "v":
+		return m.OpenSelectedQuestion(), true
+	case "P":
+		return m.TogglePreview(), true
+	case "ctrl+l":
+		return m.StartBulkFetch(), true
+	case "n":
+		return m.ChangePage(1), true
+	case "p":
+		return m.ChangePage(-1), true
+	case "esc":
+		if m.bulk != nil {
+			return m.CancelBulkFetch(), true
+		}
+	case ">":
+		return m.ResizeTitleColumn(titleStep), true
+	case "<":
+		return m.ResizeTitleColumn(-titleStep), true
+	}
+	return nil, false
+}
+
+func (m *Model) HandleQuestionViewKey(msg tea.KeyMsg) (tea.Cmd, bool) {
+	if key := GetConfig().HideQuestionKey; key != "" && msg.String() == key {
+		m.ToggleQuestionBody()
+		return nil, true
+	}
+
+	switch msg.String() {
+	case "?":
+		m.ShowHelp()
+		return nil, true
+	case "L":
+		return copyMarkdownLinkCmd(m.question, m.QuestionSite()), true
+	case "O":
+		return openURLCmd(m.FocusedLink()), true
+	case "D":
+		return m.ToggleDensity(), true
+	case "tab":
+		m.FocusAnswer(1)
+		return nil, true
+	case "shift+tab":
+		m.FocusAnswer(-1)
+		return nil, true
+	case "J":
+		m.viewport.SetYOffset(m.dividerOffset)
+		return nil, true
+	case "g", "home":
+		m.viewport.GotoTop()
+		return nil, true
+	case "l":
+		return m.ShowLinks(), true
+	case "e":
+		return m.RefineSearch(), true
+	case "E":
+		return m.ToggleQuestionComments(), true
+	case "/":
+		return m.StartFind(), true
+	case "n", "N":
+		if m.findPattern == nil {
+			return nil, false
+		}
+		if msg.String() == "N" {
+			return m.GotoMatch(-1), true
+		}
+		return m.GotoMatch(1), true
+	case "C":
+		return m.ShowAnswerSummary(), true
+	case "W":
+		return m.ShowWideTable(), true
+	case "a":
+		if m.showAllAnswers || len(m.renderedAnswers) == len(m.question.Answers) {
+			return nil, true
+		}
+		m.showAllAnswers = true
+		m.acceptedOnly = false
+		m.RenderQuestion()
+		return getLogCmd(T("Showing all %d answers", len(m.question.Answers)), Info), true
+	case "]":
+		return m.AdjustMinScore(1), true
+	case "[":
+		return m.AdjustMinScore(-1), true
+	case "A":
+		m.acceptedOnly = !m.acceptedOnly
+		m.RenderQuestion()
+		m.viewport.GotoTop()
+		if m.acceptedOnly {
+			return getLogCmd(T("Showing only the accepted answer"), Info), true
+		}
+		return getLogCmd(T("Showing all answers"), Info), true
+	case "m":
+		return m.ToggleCompareMark(), true
+	case "=":
+		return m.ShowComparison(), true
+	case "v", "V":
+		return m.ConfirmVote(msg.String() == "v"), true
+	case "r":
+		return m.RefreshQuestion(), true
+	case "t":
+		m.theme = NextMarkdownTheme(m.theme)
+		m.RenderQuestion()
+
+		GetConfig().Theme = m.theme
+		if err := SaveConfig(); err != nil {
+			return getLogCmd(T("Unable to save config: %s", err), Error), true
+		}
+		return getLogCmd(T("Theme: %s", m.theme), Info), true
+	case "+":
+		return m.Zoom(1), true
+	case "-":
+		return m.Zoom(-1), true
+	case "P":
+		return m.OpenInPager(), true
+	case "s":
+		return m.ToggleSavedAnswer(), true
+	case "T":
+		if len(m.question.Tags) <= collapsedTagCount {
+			return nil, true
+		}
+		m.showAllTags = !m.showAllTags
+		m.RenderQuestion()
+		return nil, true
+	case "S":
+		return copySnippetCmd(m.question, m.QuestionSite()), true
+	case "Q":
+		return copyQuestionCmd(m.question), true
+	case "c":
+		return copyAcceptedCodeCmd(m.question), true
+	case "y":
+		return m.ToggleAnswerMark(MarkHelpful), true
+	case "x":
+		return m.ToggleAnswerMark(MarkNotHelpful), true
+	case "Y":
+		answer, _, ok := m.CurrentAnswer()
+		return copyAnswerTextCmd(answer, ok), true
+	case "H":
+		m.highlightTerms = !m.highlightTerms
+		m.RenderQuestion()
+		if m.highlightTerms {
+			return getLogCmd(T("Highlighting search terms"), Info), true
+		}
+		return getLogCmd(T("Not highlighting search terms"), Info), true
+	case "o":
+		m.answerSort = NextAnswerSort(m.answerSort)
+		m.RenderQuestion()
+		m.viewport.GotoTop()
+
+		GetConfig().AnswerSort = m.answerSort
+		if err := SaveConfig(); err != nil {
+			return getLogCmd(T("Unable to save config: %s", err), Error), true
+		}
+		return getLogCmd(T("Sorting answers by %s", m.answerSort), Info), true
+	case "M":
+		m.plainText = !m.plainText
+		m.RenderQuestion()
+		m.viewport.GotoTop()
+		if !m.plainText {
+			return getLogCmd(T("Showing rendered markdown"), Info), true
+		}
+		if m.mouse != MouseOff {
+			return getLogCmd(T("Showing plain text, turn the mouse off with ctrl+s to select it"), Info), true
+		}
+		return getLogCmd(T("Showing plain text"), Info), true
+	case "#":
+		m.lineNumbers = !m.lineNumbers
+		m.RenderQuestion()
+		if m.lineNumbers {
+			return getLogCmd(T("Showing line numbers in code blocks"), Info), true
+		}
+		return getLogCmd(T("Hiding line numbers in code blocks"), Info), true
+	case "p":
+		m.proseOnly = !m.proseOnly
+		m.RenderQuestion()
+		if m.proseOnly {
+			return getLogCmd(T("Hiding code blocks"), Info), true
+		}
+		return getLogCmd(T("Showing code blocks"), Info), true
+	}
+	return nil, false
+}
+
+func (m *Model) HandleLinkListKey(msg tea.KeyMsg) (tea.Cmd, bool) {
+	switch msg.String() {
+	case "enter":
+		if link, ok := m.SelectedLink(); ok {
+			return openURLCmd(link.URL), true
+		}
+		return nil, true
+	case "a":
+		return openAllLinksCmd(m.links), true
+	case "backspace":
+		m.HideLinks()
+		return nil, true
+	}
+	return nil, false
+}
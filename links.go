@@ -0,0 +1,114 @@
+package main
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type Link struct {
+	Text string
+	URL  string
+}
+
+var (
+	inlineLinkRe    = regexp.MustCompile(`\[([^\]]*)\]\((https?://[^)\s]+)[^)]*\)`)
+	referenceLinkRe = regexp.MustCompile(`(?m)^\s*\[([^\]]+)\]:\s*<?(https?://[^\s>]+)>?`)
+	bareLinkRe      = regexp.MustCompile(`https?://[^\s<>()\[\]"']+`)
+)
+
+func ExtractLinks(markdown string) []Link {
+	links := []Link{}
+	seen := map[string]bool{}
+
+	add := func(text string, url string) {
+		if seen[url] {
+			return
+		}
+		seen[url] = true
+		if text == "" {
+			text = url
+		}
+		links = append(links, Link{Text: text, URL: url})
+	}
+
+	for _, match := range inlineLinkRe.FindAllStringSubmatch(markdown, -1) {
+		add(match[1], match[2])
+	}
+	for _, match := range referenceLinkRe.FindAllStringSubmatch(markdown, -1) {
+		add(match[1], match[2])
+	}
+	for _, url := range bareLinkRe.FindAllString(markdown, -1) {
+		add("", strings.TrimRight(url, ".,;:!?"))
+	}
+
+	return links
+}
+
+func (m *Model) ShowLinks() tea.Cmd {
+	answer, index, ok := m.CurrentAnswer()
+	if !ok {
+		return getLogCmd(T("No answer in view"), Warning)
+	}
+
+	m.links = ExtractLinks(answer.BodyMarkdown)
+	if len(m.links) == 0 {
+		return getLogCmd(T("No links in answer %d", index+1), Warning)
+	}
+
+	rows := []table.Row{}
+	for _, link := range m.links {
+		rows = append(rows, table.Row{
+			fitCell(link.Text, m.LinkColumnWidths()[0]),
+			fitCell(link.URL, m.LinkColumnWidths()[1]),
+		})
+	}
+
+	m.SetLinkHeaders()
+	m.linkTable.SetRows(rows)
+	m.linkTable.GotoTop()
+	m.linkTable.Focus()
+	m.state = DisplayingLinks
+
+	return nil
+}
+
+func (m Model) LinkColumnWidths() []int {
+	width := m.table.Width()
+	return []int{int(0.4 * float32(width)), int(0.6 * float32(width))}
+}
+
+func (m *Model) SetLinkHeaders() {
+	widths := m.LinkColumnWidths()
+
+	m.linkTable.SetWidth(m.table.Width())
+	m.linkTable.SetHeight(m.table.Height())
+	m.linkTable.SetColumns([]table.Column{
+		{Title: "Text", Width: widths[0]},
+		{Title: "URL", Width: widths[1]},
+	})
+}
+
+func (m *Model) HideLinks() {
+	m.linkTable.Blur()
+	m.state = DisplayingQuestionAndAnswers
+}
+
+func (m Model) SelectedLink() (Link, bool) {
+	cursor := m.linkTable.Cursor()
+	if cursor < 0 || cursor >= len(m.links) {
+		return Link{}, false
+	}
+	return m.links[cursor], true
+}
+
+func openAllLinksCmd(links []Link) tea.Cmd {
+	for _, link := range links {
+		if err := OpenURL(link.URL); err != nil {
+			return getLogCmd(T("Unable to open %s: %s", link.URL, err), Error)
+		}
+	}
+	return getLogCmd(T("Opened %d links", len(links)), Info)
+}
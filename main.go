@@ -1,5 +1,41 @@
 package main
 
+import (
+	"flag"
+	"fmt"
+	"os"
+)
+
+var version = "dev"
+
 func main() {
-	RunTUI()
+	showVersion := flag.Bool("version", false, "Print the version and exit")
+	site := flag.String("site", "", "Site to search, e.g. stackoverflow or superuser")
+	tags := flag.String("tags", "", "Comma-separated tags to restrict the search to")
+	query := flag.String("query", "", "Search for this query on startup")
+	clearCache := flag.Bool("clear-cache", false, "Delete cached responses and exit")
+	flag.Parse()
+
+	if *showVersion {
+		fmt.Println("sotui " + version)
+		return
+	}
+
+	if *clearCache {
+		size, err := ClearCache()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		fmt.Printf("Freed %s\n", FormatBytes(size))
+		return
+	}
+
+	options, err := ParseCLIOptions(*site, *tags, *query, flag.Args())
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	RunTUI(options)
 }
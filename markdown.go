@@ -0,0 +1,219 @@
+package main
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+)
+
+type MarkdownSegment struct {
+	Text     string
+	Code     bool
+	Language string
+}
+
+func isIndentedCode(line string) bool {
+	return strings.HasPrefix(line, "    ") || strings.HasPrefix(line, "\t")
+}
+
+func SplitCodeBlocks(markdown string) []MarkdownSegment {
+	segments := []MarkdownSegment{}
+	lines := strings.Split(strings.ReplaceAll(markdown, "\r\n", "\n"), "\n")
+
+	prose := []string{}
+	flushProse := func() {
+		if len(prose) > 0 {
+			segments = append(segments, MarkdownSegment{Text: strings.Join(prose, "\n")})
+			prose = []string{}
+		}
+	}
+
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+		trimmed := strings.TrimSpace(line)
+
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			fence := trimmed[:3]
+			language := strings.TrimSpace(strings.Trim(trimmed, "`~"))
+			code := []string{}
+
+			for i++; i < len(lines) && !strings.HasPrefix(strings.TrimSpace(lines[i]), fence); i++ {
+				code = append(code, lines[i])
+			}
+
+			flushProse()
+			segments = append(segments, MarkdownSegment{Text: strings.Join(code, "\n"), Code: true, Language: language})
+			continue
+		}
+
+		previousBlank := i == 0 || strings.TrimSpace(lines[i-1]) == ""
+		if isIndentedCode(line) && previousBlank && trimmed != "" {
+			code := []string{}
+			for ; i < len(lines) && (isIndentedCode(lines[i]) || strings.TrimSpace(lines[i]) == ""); i++ {
+				code = append(code, strings.TrimPrefix(strings.TrimPrefix(lines[i], "    "), "\t"))
+			}
+			i--
+
+			for len(code) > 0 && strings.TrimSpace(code[len(code)-1]) == "" {
+				code = code[:len(code)-1]
+			}
+
+			flushProse()
+			segments = append(segments, MarkdownSegment{Text: strings.Join(code, "\n"), Code: true})
+			prose = append(prose, "")
+			continue
+		}
+
+		prose = append(prose, line)
+	}
+	flushProse()
+
+	return segments
+}
+
+func StripCodeBlocks(markdown string) string {
+	prose := []string{}
+	hidden := 0
+
+	for _, segment := range SplitCodeBlocks(markdown) {
+		if segment.Code {
+			hidden++
+		} else {
+			prose = append(prose, segment.Text)
+		}
+	}
+
+	if hidden == 0 {
+		return markdown
+	}
+	return strings.Join(prose, "\n\n") + fmt.Sprintf("\n\n*[%d code blocks hidden]*", hidden)
+}
+
+var protectedMarkdown = regexp.MustCompile("`[^`]*`|\\]\\([^)]*\\)|<[^>]*>|https?://\\S+")
+
+func JoinSegments(segments []MarkdownSegment) string {
+	parts := []string{}
+	for _, segment := range segments {
+		if segment.Code {
+			parts = append(parts, "```"+segment.Language+"\n"+segment.Text+"\n```")
+		} else {
+			parts = append(parts, segment.Text)
+		}
+	}
+	return strings.Join(parts, "\n")
+}
+
+func TermsPattern(terms []string) *regexp.Regexp {
+	quoted := []string{}
+	for _, term := range terms {
+		if len(term) > 1 {
+			quoted = append(quoted, regexp.QuoteMeta(term))
+		}
+	}
+	if len(quoted) == 0 {
+		return nil
+	}
+	return regexp.MustCompile(`(?i)\b(` + strings.Join(quoted, "|") + `)\b`)
+}
+
+func HighlightTerms(markdown string, pattern *regexp.Regexp) string {
+	if pattern == nil {
+		return markdown
+	}
+
+	segments := SplitCodeBlocks(markdown)
+	for i, segment := range segments {
+		if segment.Code {
+			continue
+		}
+
+		text := segment.Text
+		highlighted := ""
+		last := 0
+		for _, loc := range protectedMarkdown.FindAllStringIndex(text, -1) {
+			highlighted += pattern.ReplaceAllString(text[last:loc[0]], "**$1**") + text[loc[0]:loc[1]]
+			last = loc[1]
+		}
+		segments[i].Text = highlighted + pattern.ReplaceAllString(text[last:], "**$1**")
+	}
+	return JoinSegments(segments)
+}
+
+var plainTextReplacements = []struct {
+	pattern     *regexp.Regexp
+	replacement string
+}{
+	{regexp.MustCompile(`!\[([^\]]*)\]\([^)]*\)`), "$1"},
+	{regexp.MustCompile(`\[([^\]]*)\]\(([^)\s]*)[^)]*\)`), "$1 ($2)"},
+	{regexp.MustCompile(`\[([^\]]*)\]\[[^\]]*\]`), "$1"},
+	{regexp.MustCompile(`(?m)^[ \t]*\[[^\]]+\]:[ \t]*(\S+).*$`), "$1"},
+	{regexp.MustCompile(`(?m)^[ \t]{0,3}#{1,6}[ \t]+`), ""},
+	{regexp.MustCompile(`(?m)^[ \t]{0,3}>[ \t]?`), ""},
+	{regexp.MustCompile(`(?m)^[ \t]{0,3}([-*_][ \t]*){3,}$`), ""},
+	{regexp.MustCompile(`(\*\*|__)(\S(?:.*?\S)?)(\*\*|__)`), "$2"},
+	{regexp.MustCompile(`(^|[^\w*])\*(\S(?:.*?\S)?)\*`), "$1$2"},
+	{regexp.MustCompile(`~~(\S(?:.*?\S)?)~~`), "$1"},
+	{regexp.MustCompile("`([^`]*)`"), "$1"},
+	{regexp.MustCompile(`<[^>\s]+>`), ""},
+}
+
+func MarkdownToText(markdown string) string {
+	parts := []string{}
+	for _, segment := range SplitCodeBlocks(markdown) {
+		text := segment.Text
+		if !segment.Code {
+			for _, r := range plainTextReplacements {
+				text = r.pattern.ReplaceAllString(text, r.replacement)
+			}
+			text = html.UnescapeString(text)
+		}
+		if text = strings.Trim(text, "\n"); text != "" {
+			parts = append(parts, text)
+		}
+	}
+	return strings.Join(parts, "\n\n")
+}
+
+var tableSeparator = regexp.MustCompile(`^\s*\|?\s*:?-+:?\s*(\|\s*:?-+:?\s*)+\|?\s*$`)
+
+func ExtractTables(markdown string) []string {
+	tables := []string{}
+	for _, segment := range SplitCodeBlocks(markdown) {
+		if segment.Code {
+			continue
+		}
+
+		lines := strings.Split(segment.Text, "\n")
+		for i := 1; i < len(lines); i++ {
+			if !strings.Contains(lines[i-1], "|") || !tableSeparator.MatchString(lines[i]) {
+				continue
+			}
+
+			end := i + 1
+			for end < len(lines) && strings.Contains(lines[end], "|") {
+				end++
+			}
+			tables = append(tables, strings.Join(lines[i-1:end], "\n"))
+			i = end
+		}
+	}
+	return tables
+}
+
+func NumberCodeLines(markdown string) string {
+	segments := SplitCodeBlocks(markdown)
+	for i, segment := range segments {
+		if !segment.Code {
+			continue
+		}
+
+		lines := strings.Split(segment.Text, "\n")
+		digits := len(fmt.Sprint(len(lines)))
+		for j, line := range lines {
+			lines[j] = fmt.Sprintf("%*d │ %s", digits, j+1, line)
+		}
+		segments[i].Text = strings.Join(lines, "\n")
+	}
+	return JoinSegments(segments)
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"sort"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const (
+	MarkHelpful    = "helpful"
+	MarkNotHelpful = "not helpful"
+)
+
+type AnswerMark struct {
+	SavedAnswer
+	Mark string `json:"mark"`
+}
+
+func answerMarksPath() string {
+	return GetDataDir() + "/answer_marks.json"
+}
+
+func LoadAnswerMarks() (map[int]AnswerMark, error) {
+	marks := map[int]AnswerMark{}
+
+	data, err := os.ReadFile(answerMarksPath())
+	if os.IsNotExist(err) {
+		return marks, nil
+	} else if err != nil {
+		return marks, err
+	}
+
+	list := []AnswerMark{}
+	if err := json.Unmarshal(data, &list); err != nil {
+		return marks, err
+	}
+	for _, mark := range list {
+		marks[mark.AnswerID] = mark
+	}
+	return marks, nil
+}
+
+func WriteAnswerMarks(marks map[int]AnswerMark) error {
+	if err := os.MkdirAll(GetDataDir(), 0700); err != nil {
+		return err
+	}
+
+	list := []AnswerMark{}
+	for _, mark := range marks {
+		list = append(list, mark)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].SavedAt > list[j].SavedAt })
+
+	data, err := json.MarshalIndent(list, "", "  ")
+	if err != nil {
+		return err
+	}
+	return WriteFileAtomic(answerMarksPath(), data)
+}
+
+func (m *Model) ToggleAnswerMark(mark string) tea.Cmd {
+	answer, _, ok := m.CurrentAnswer()
+	if !ok {
+		return getLogCmd(T("No answer to mark"), Warning)
+	}
+
+	msg := T("Marked answer as %s", T(mark))
+	if m.answerMarks[answer.AnswerID].Mark == mark {
+		delete(m.answerMarks, answer.AnswerID)
+		msg = T("Cleared answer mark")
+	} else {
+		m.answerMarks[answer.AnswerID] = AnswerMark{
+			SavedAnswer: SavedAnswer{
+				AnswerID:   answer.AnswerID,
+				QuestionID: m.question.QuestionID,
+				Site:       m.QuestionSite(),
+				Title:      m.question.DecodedTitle(),
+				Link:       AnswerURL(m.QuestionSite(), m.question.QuestionID, answer.AnswerID),
+				Excerpt:    Excerpt(answer.BodyMarkdown),
+				SavedAt:    time.Now().Unix(),
+			},
+			Mark: mark,
+		}
+	}
+
+	if err := WriteAnswerMarks(m.answerMarks); err != nil {
+		return getLogCmd(T("Unable to save answer marks: %s", err), Error)
+	}
+	m.RenderQuestion()
+	return getLogCmd(msg, Info)
+}
+
+func (m *Model) ShowHelpfulAnswers() tea.Cmd {
+	helpful := []SavedAnswer{}
+	for _, mark := range m.answerMarks {
+		if mark.Mark == MarkHelpful {
+			helpful = append(helpful, mark.SavedAnswer)
+		}
+	}
+	if len(helpful) == 0 {
+		return getLogCmd(T("No answers marked helpful yet, press y on an answer to mark it"), Warning)
+	}
+	sort.Slice(helpful, func(i, j int) bool { return helpful[i].SavedAt > helpful[j].SavedAt })
+
+	m.savedAnswers = helpful
+	m.SetSavedAnswerHeaders()
+	m.RefreshSavedAnswers()
+	m.savedTable.GotoTop()
+	m.savedTable.Focus()
+	m.textarea.Blur()
+	m.table.Blur()
+	m.state = DisplayingHelpfulAnswers
+	return nil
+}
+
+func (m *Model) HandleHelpfulAnswerKey(msg tea.KeyMsg) (tea.Cmd, bool) {
+	switch msg.String() {
+	case "x", "delete":
+		entry, index, ok := m.SelectedSavedAnswer()
+		if !ok {
+			return nil, true
+		}
+
+		delete(m.answerMarks, entry.AnswerID)
+		if err := WriteAnswerMarks(m.answerMarks); err != nil {
+			return getLogCmd(T("Unable to save answer marks: %s", err), Error), true
+		}
+		m.savedAnswers = append(m.savedAnswers[:index], m.savedAnswers[index+1:]...)
+		m.RefreshSavedAnswers()
+		if len(m.savedAnswers) == 0 {
+			m.HideSavedAnswers()
+		}
+		return getLogCmd(T("Cleared answer mark"), Info), true
+	}
+	return m.HandleSavedAnswerKey(msg)
+}
+
+func answerMarkView(mark AnswerMark) string {
+	switch mark.Mark {
+	case MarkHelpful:
+		return PositiveScoreStyle.Render(T("♥ helpful"))
+	case MarkNotHelpful:
+		return NegativeScoreStyle.Render(T("✗ not helpful"))
+	}
+	return ""
+}
@@ -0,0 +1,131 @@
+package main
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	displayMath = regexp.MustCompile(`\$\$([^$]+)\$\$`)
+	inlineMath  = regexp.MustCompile(`\$(\S|\S[^$\n]*\S)\$`)
+	mathFrac    = regexp.MustCompile(`\\[dt]?frac\{([^{}]*)\}\{([^{}]*)\}`)
+	mathSqrt    = regexp.MustCompile(`\\sqrt\{([^{}]*)\}`)
+	mathScript  = regexp.MustCompile(`([\^_])(\{[^{}]*\}|[0-9a-zA-Z+\-=()])`)
+	mathFont    = regexp.MustCompile(`\\(?:mathrm|mathbf|mathit|text|operatorname)\{([^{}]*)\}`)
+	mathCommand = regexp.MustCompile(`\\[a-zA-Z]+`)
+)
+
+var mathSymbols = [][2]string{
+	{`\alpha`, "α"}, {`\beta`, "β"}, {`\gamma`, "γ"}, {`\delta`, "δ"}, {`\epsilon`, "ε"}, {`\varepsilon`, "ε"},
+	{`\zeta`, "ζ"}, {`\eta`, "η"}, {`\theta`, "θ"}, {`\iota`, "ι"}, {`\kappa`, "κ"}, {`\lambda`, "λ"},
+	{`\mu`, "μ"}, {`\nu`, "ν"}, {`\xi`, "ξ"}, {`\pi`, "π"}, {`\rho`, "ρ"}, {`\sigma`, "σ"},
+	{`\tau`, "τ"}, {`\upsilon`, "υ"}, {`\phi`, "φ"}, {`\varphi`, "φ"}, {`\chi`, "χ"}, {`\psi`, "ψ"},
+	{`\omega`, "ω"}, {`\Gamma`, "Γ"}, {`\Delta`, "Δ"}, {`\Theta`, "Θ"}, {`\Lambda`, "Λ"}, {`\Xi`, "Ξ"},
+	{`\Pi`, "Π"}, {`\Sigma`, "Σ"}, {`\Phi`, "Φ"}, {`\Psi`, "Ψ"}, {`\Omega`, "Ω"}, {`\sum`, "∑"},
+	{`\prod`, "∏"}, {`\int`, "∫"}, {`\oint`, "∮"}, {`\partial`, "∂"}, {`\nabla`, "∇"}, {`\infty`, "∞"},
+	{`\leq`, "≤"}, {`\le`, "≤"}, {`\geq`, "≥"}, {`\ge`, "≥"}, {`\neq`, "≠"}, {`\ne`, "≠"},
+	{`\approx`, "≈"}, {`\equiv`, "≡"}, {`\sim`, "∼"}, {`\propto`, "∝"}, {`\pm`, "±"}, {`\mp`, "∓"},
+	{`\times`, "×"}, {`\cdot`, "·"}, {`\div`, "÷"}, {`\to`, "→"}, {`\rightarrow`, "→"}, {`\leftarrow`, "←"},
+	{`\Rightarrow`, "⇒"}, {`\Leftarrow`, "⇐"}, {`\iff`, "⇔"}, {`\implies`, "⇒"}, {`\mapsto`, "↦"}, {`\in`, "∈"},
+	{`\notin`, "∉"}, {`\subset`, "⊂"}, {`\subseteq`, "⊆"}, {`\supset`, "⊃"}, {`\cup`, "∪"}, {`\cap`, "∩"},
+	{`\emptyset`, "∅"}, {`\forall`, "∀"}, {`\exists`, "∃"}, {`\neg`, "¬"}, {`\land`, "∧"}, {`\lor`, "∨"},
+	{`\ldots`, "…"}, {`\cdots`, "⋯"}, {`\dots`, "…"}, {`\circ`, "∘"}, {`\deg`, "deg"}, {`\mathbb{R}`, "ℝ"},
+	{`\mathbb{N}`, "ℕ"}, {`\mathbb{Z}`, "ℤ"}, {`\mathbb{Q}`, "ℚ"}, {`\mathbb{C}`, "ℂ"}, {`\sin`, "sin"}, {`\cos`, "cos"},
+	{`\tan`, "tan"}, {`\log`, "log"}, {`\ln`, "ln"}, {`\exp`, "exp"}, {`\lim`, "lim"}, {`\max`, "max"},
+	{`\min`, "min"}, {`\left`, ""}, {`\right`, ""}, {`\,`, " "}, {`\;`, " "}, {`\!`, ""},
+	{`\quad`, " "},
+}
+
+var mathSymbolLookup = map[string]string{}
+
+func init() {
+	for _, pair := range mathSymbols {
+		mathSymbolLookup[pair[0]] = pair[1]
+	}
+}
+
+const (
+	escapedOpenBrace  = "\ue000"
+	escapedCloseBrace = "\ue001"
+)
+
+var (
+	superscripts = strings.NewReplacer("0", "⁰", "1", "¹", "2", "²", "3", "³", "4", "⁴", "5", "⁵", "6", "⁶", "7", "⁷", "8", "⁸", "9", "⁹", "+", "⁺", "-", "⁻", "=", "⁼", "(", "⁽", ")", "⁾", "n", "ⁿ", "i", "ⁱ")
+	subscripts   = strings.NewReplacer("0", "₀", "1", "₁", "2", "₂", "3", "₃", "4", "₄", "5", "₅", "6", "₆", "7", "₇", "8", "₈", "9", "₉", "+", "₊", "-", "₋", "=", "₌", "(", "₍", ")", "₎")
+)
+
+func ConvertLatex(latex string) (string, bool) {
+	text := strings.TrimSpace(latex)
+	text = strings.NewReplacer(`\{`, escapedOpenBrace, `\}`, escapedCloseBrace).Replace(text)
+	text = mathFont.ReplaceAllString(text, "$1")
+	for i := 0; i < 3; i++ {
+		text = mathFrac.ReplaceAllString(text, "($1)/($2)")
+		text = mathSqrt.ReplaceAllString(text, "√($1)")
+	}
+
+	text = mathCommand.ReplaceAllStringFunc(text, func(command string) string {
+		if symbol, ok := mathSymbolLookup[command]; ok {
+			return symbol
+		}
+		return command
+	})
+	for _, pair := range mathSymbols {
+		if command := pair[0]; strings.Contains(command, "{") || !mathCommand.MatchString(command) {
+			text = strings.ReplaceAll(text, command, pair[1])
+		}
+	}
+
+	ok := true
+	text = mathScript.ReplaceAllStringFunc(text, func(script string) string {
+		body := strings.Trim(script[1:], "{}")
+		replacer := superscripts
+		if script[0] == '_' {
+			replacer = subscripts
+		}
+		converted := replacer.Replace(body)
+		for _, r := range body {
+			if strings.ContainsRune(converted, r) {
+				return string(script[0]) + "(" + body + ")"
+			}
+		}
+		return converted
+	})
+
+	if mathCommand.MatchString(text) {
+		ok = false
+	}
+	text = strings.NewReplacer("{", "", "}", "").Replace(text)
+	return strings.NewReplacer(escapedOpenBrace, "{", escapedCloseBrace, "}").Replace(text), ok
+}
+
+func mathSpan(latex string) string {
+	converted, ok := ConvertLatex(latex)
+	if !ok {
+		converted = strings.TrimSpace(latex)
+	}
+	return "`" + strings.ReplaceAll(converted, "`", "'") + "`"
+}
+
+func PrepareMath(markdown string) string {
+	if !GetConfig().RenderMath {
+		return markdown
+	}
+	return RenderMath(markdown)
+}
+
+func RenderMath(markdown string) string {
+	segments := SplitCodeBlocks(markdown)
+	for i, segment := range segments {
+		if segment.Code {
+			continue
+		}
+
+		text := displayMath.ReplaceAllStringFunc(segment.Text, func(span string) string {
+			return mathSpan(span[2 : len(span)-2])
+		})
+		segments[i].Text = inlineMath.ReplaceAllStringFunc(text, func(span string) string {
+			return mathSpan(span[1 : len(span)-1])
+		})
+	}
+	return JoinSegments(segments)
+}
@@ -0,0 +1,27 @@
+package main
+
+import "testing"
+
+func TestConvertLatex(t *testing.T) {
+	tests := []struct {
+		latex string
+		want  string
+		ok    bool
+	}{
+		{`\{1,2\}`, "{1,2}", true},
+		{`x \in \left\{ a, b \right\}`, "x ∈ { a, b }", true},
+		{`\frac{a}{b}`, "(a)/(b)", true},
+		{`x^2 + y_1`, "x² + y₁", true},
+		{`\alpha \leq \beta`, "α ≤ β", true},
+		{`\deg f`, "deg f", true},
+		{`\mathbb{R}^n`, "ℝⁿ", true},
+		{`\unknown{x}`, `\unknownx`, false},
+	}
+
+	for _, test := range tests {
+		got, ok := ConvertLatex(test.latex)
+		if got != test.want || ok != test.ok {
+			t.Errorf("ConvertLatex(%q) = %q, %v, want %q, %v", test.latex, got, ok, test.want, test.ok)
+		}
+	}
+}
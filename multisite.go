@@ -0,0 +1,128 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type multiSiteMsg struct {
+	ID       int
+	Site     string
+	Response SEResponse
+	Err      error
+}
+
+func (m *Model) StartMultiSiteSearch(query string) tea.Cmd {
+	sites := GetConfig().FavoriteSites
+	if len(sites) == 0 {
+		return getLogCmd(T("No favorite sites configured"), Warning)
+	}
+
+	parsed, err := ParseQuery(query)
+	cmds := []tea.Cmd{spinner.Tick}
+	if err != nil {
+		cmds = append(cmds, getLogCmd(T("Unable to parse query (%s), searching as plain text", err), Warning))
+	}
+
+	if m.siteCounts == nil {
+		m.multiSortCol, m.multiSortDesc = m.sortColumn, m.sortDesc
+	}
+	m.multiSiteID++
+	id := m.multiSiteID
+
+	for _, site := range sites {
+		site := site
+		cmds = append(cmds, func() tea.Msg {
+			resp, err := Search(parsed, site, "", "", "")
+			return multiSiteMsg{ID: id, Site: site, Response: resp, Err: err}
+		})
+	}
+
+	m.query = query
+	m.home = false
+	m.response = SEResponse{}
+	m.siteCounts = map[string]int{}
+	m.pendingSites = len(sites)
+	m.sortColumn = 2
+	m.sortDesc = true
+	m.textarea.Reset()
+	m.StartWaiting(T("Searching %d sites...", len(sites)))
+	return tea.Batch(cmds...)
+}
+
+// EndMultiSiteSearch leaves multi-site mode and restores the sort that was
+// active before the score sort it switched to.
+func (m *Model) EndMultiSiteSearch() {
+	if m.siteCounts == nil {
+		return
+	}
+	m.siteCounts = nil
+	m.sortColumn, m.sortDesc = m.multiSortCol, m.multiSortDesc
+	m.SetTableHeaders()
+}
+
+func (m *Model) MergeSiteResults(msg multiSiteMsg) tea.Cmd {
+	if m.siteCounts == nil || msg.ID != m.multiSiteID {
+		return nil
+	}
+	m.pendingSites--
+
+	var cmd tea.Cmd
+	if msg.Err != nil {
+		cmd = getLogCmd(T("%s: %s", msg.Site, msg.Err), Warning)
+	} else {
+		for _, item := range msg.Response.Items {
+			item.Site = msg.Site
+			m.response.Items = append(m.response.Items, item)
+		}
+		m.siteCounts[msg.Site] = len(msg.Response.Items)
+	}
+
+	if len(m.response.Items) == 0 {
+		if m.pendingSites == 0 {
+			m.EndMultiSiteSearch()
+			m.state = WaitingForInput
+			m.textarea.Focus()
+			return tea.Batch(cmd, getLogCmd(T("No results found"), Warning))
+		}
+		return cmd
+	}
+
+	if m.state == WaitingForResponse {
+		m.statusMsg = ""
+		m.state = DisplayingAllQuestions
+		m.filter.SetValue("")
+		m.textarea.Blur()
+		m.table.Focus()
+	}
+
+	m.response.Items = TrimResults(DedupeResults(m.response.Items, map[string]bool{}), GetConfig().MaxResults)
+	m.RefreshRows()
+	m.SortResults()
+	return cmd
+}
+
+func (m Model) SiteCountsView() string {
+	if m.siteCounts == nil {
+		return ""
+	}
+
+	sites := []string{}
+	for site := range m.siteCounts {
+		sites = append(sites, site)
+	}
+	sort.Strings(sites)
+
+	counts := []string{}
+	for _, site := range sites {
+		counts = append(counts, fmt.Sprintf("%s %d", site, m.siteCounts[site]))
+	}
+	if m.pendingSites > 0 {
+		counts = append(counts, T("%d pending", m.pendingSites))
+	}
+	return strings.Join(counts, " · ")
+}
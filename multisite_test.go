@@ -0,0 +1,60 @@
+package main
+
+import "testing"
+
+func TestMultiSiteSearchDropsStaleResponses(t *testing.T) {
+	favorites := GetConfig().FavoriteSites
+	GetConfig().FavoriteSites = []string{"stackoverflow", "superuser"}
+	t.Cleanup(func() { GetConfig().FavoriteSites = favorites })
+	m := newTestModel(WaitingForInput)
+
+	m.StartMultiSiteSearch("first")
+	stale := m.multiSiteID
+	m.StartMultiSiteSearch("second")
+
+	m.MergeSiteResults(multiSiteMsg{ID: stale, Site: "superuser", Response: SEResponse{Items: []ResponseItem{{QuestionID: 1}}}})
+	if len(m.response.Items) != 0 || m.pendingSites != 2 {
+		t.Errorf("stale response merged: %d items, %d pending", len(m.response.Items), m.pendingSites)
+	}
+
+	m.MergeSiteResults(multiSiteMsg{ID: m.multiSiteID, Site: "superuser", Response: SEResponse{Items: []ResponseItem{{QuestionID: 2}}}})
+	if len(m.response.Items) != 1 || m.response.Items[0].QuestionID != 2 {
+		t.Errorf("items = %+v", m.response.Items)
+	}
+}
+
+func TestMultiSiteSearchRestoresSort(t *testing.T) {
+	favorites := GetConfig().FavoriteSites
+	GetConfig().FavoriteSites = []string{"stackoverflow"}
+	t.Cleanup(func() { GetConfig().FavoriteSites = favorites })
+	m := newTestModel(WaitingForInput)
+	m.sortColumn, m.sortDesc = 4, false
+
+	m.StartMultiSiteSearch("first")
+	m.StartMultiSiteSearch("second")
+	if m.sortColumn != 2 || !m.sortDesc {
+		t.Fatalf("multi-site sort = %d, %v", m.sortColumn, m.sortDesc)
+	}
+
+	updated, _ := m.Update(SEResponse{Items: []ResponseItem{{QuestionID: 1}}})
+	m = updated.(Model)
+	if m.sortColumn != 4 || m.sortDesc {
+		t.Errorf("sort after a plain search = %d, %v; want 4, false", m.sortColumn, m.sortDesc)
+	}
+}
+
+func TestShowQuestionKeepsSearchSite(t *testing.T) {
+	m := newTestModel(DisplayingAllQuestions)
+	m.site = "stackoverflow"
+
+	m.ShowQuestion(ResponseItem{QuestionID: 1, Site: "superuser"})
+	if m.site != "stackoverflow" {
+		t.Errorf("site = %q, want stackoverflow", m.site)
+	}
+	if site := m.QuestionSite(); site != "superuser" {
+		t.Errorf("question site = %q, want superuser", site)
+	}
+	if link := m.FocusedLink(); link != "https://superuser.com/questions/1" {
+		t.Errorf("link = %q", link)
+	}
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type pagerMsg struct {
+	Path string
+	Err  error
+}
+
+func (m Model) OpenInPager() tea.Cmd {
+	pager := strings.Fields(os.Getenv("PAGER"))
+	if len(pager) == 0 {
+		return getLogCmd(T("$PAGER is not set, staying in the built-in viewer"), Warning)
+	}
+
+	file, err := os.CreateTemp("", "sotui-*.txt")
+	if err != nil {
+		return getLogCmd(T("Unable to create a file for the pager: %s", err), Error)
+	}
+	defer file.Close()
+
+	if _, err := file.WriteString(m.renderedContent); err != nil {
+		os.Remove(file.Name())
+		return getLogCmd(T("Unable to write the pager file: %s", err), Error)
+	}
+
+	if pager[0] == "less" && len(pager) == 1 {
+		pager = append(pager, "-R")
+	}
+	path := file.Name()
+	cmd := exec.Command(pager[0], append(pager[1:], path)...)
+
+	return tea.ExecProcess(cmd, func(err error) tea.Msg {
+		return pagerMsg{Path: path, Err: err}
+	})
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const pageSize = 30
+
+type pageMsg struct {
+	ID       int
+	Page     int
+	Response SEResponse
+	Err      error
+}
+
+func (m *Model) ResetPages(response SEResponse) {
+	m.pageID++
+	m.page = 1
+	m.lastPage = 0
+	m.pages = map[int]SEResponse{1: response}
+	if !response.HasMore {
+		m.lastPage = 1
+	}
+}
+
+func (m Model) Pageable() bool {
+	query, _ := ParseQuery(m.query)
+	return m.pages != nil && m.siteCounts == nil && (m.home || query.IsAdvanced())
+}
+
+func (m *Model) ChangePage(delta int) tea.Cmd {
+	if m.bulk != nil {
+		return nil
+	}
+	if !m.Pageable() {
+		return getLogCmd(T("Only tag or quoted searches and hot questions have pages"), Warning)
+	}
+
+	target := m.page + delta
+	if target < 1 {
+		return getLogCmd(T("Already on the first page"), Warning)
+	}
+	if _, ok := m.pages[target]; ok {
+		m.GotoPage(target)
+		return nil
+	}
+	if !m.pages[m.page].HasMore {
+		return getLogCmd(T("No more pages"), Warning)
+	}
+
+	id, home, site := m.pageID, m.home, m.site
+	query, _ := ParseQuery(m.query)
+	return tea.Batch(getLogCmd(T("Loading page %d...", target), Info), func() tea.Msg {
+		var resp SEResponse
+		var err error
+		if home {
+			resp, err = GetHotQuestionsPage(site, target, pageSize)
+		} else {
+			resp, err = SearchAdvancedPage(query, site, target, pageSize)
+		}
+		return pageMsg{ID: id, Page: target, Response: resp, Err: err}
+	})
+}
+
+func (m *Model) ApplyPage(msg pageMsg) tea.Cmd {
+	if msg.ID != m.pageID || m.state != DisplayingAllQuestions {
+		return nil
+	}
+	if msg.Err != nil {
+		return getLogCmd(T("Loading page %d failed: %s", msg.Page, msg.Err), Error)
+	}
+	if len(msg.Response.Items) == 0 {
+		m.lastPage = msg.Page - 1
+		m.pages[m.lastPage] = SEResponse{Items: m.pages[m.lastPage].Items}
+		return getLogCmd(T("No more pages"), Warning)
+	}
+
+	seen := map[string]bool{}
+	for page := 1; page < msg.Page; page++ {
+		DedupeResults(m.pages[page].Items, seen)
+	}
+	msg.Response.Items = DedupeResults(msg.Response.Items, seen)
+
+	m.pages[msg.Page] = msg.Response
+	if !msg.Response.HasMore {
+		m.lastPage = msg.Page
+	}
+	m.GotoPage(msg.Page)
+	return nil
+}
+
+func (m *Model) GotoPage(page int) {
+	m.page = page
+	m.response.Items = RankResults(TrimResults(m.pages[page].Items, GetConfig().MaxResults))
+	m.response.HasMore = m.pages[page].HasMore
+	m.RefreshRows()
+	m.SortResults()
+	m.table.GotoTop()
+}
+
+func (m Model) PageView() string {
+	if !m.Pageable() {
+		return ""
+	}
+	if m.lastPage > 0 {
+		return T(" · page %d of %d", m.page, m.lastPage)
+	}
+	return T(" · page %d", m.page)
+}
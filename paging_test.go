@@ -0,0 +1,28 @@
+package main
+
+import "testing"
+
+func pageItems(ids ...int) []ResponseItem {
+	items := []ResponseItem{}
+	for _, id := range ids {
+		items = append(items, ResponseItem{QuestionID: id, Title: "question"})
+	}
+	return items
+}
+
+func TestApplyPageDropsOverlappingQuestions(t *testing.T) {
+	m := newTestModel(DisplayingAllQuestions)
+	m.home = true
+	m.ResetPages(SEResponse{Items: pageItems(1, 2), HasMore: true})
+	m.GotoPage(1)
+
+	m.ApplyPage(pageMsg{ID: m.pageID, Page: 2, Response: SEResponse{Items: pageItems(2, 3, 3), HasMore: true}})
+	if rows := len(m.table.Rows()); rows != 1 {
+		t.Errorf("page 2 has %d rows, want 1", rows)
+	}
+
+	m.ApplyPage(pageMsg{ID: m.pageID, Page: 3, Response: SEResponse{Items: pageItems(1, 3, 4)}})
+	if rows := len(m.table.Rows()); rows != 1 {
+		t.Errorf("page 3 has %d rows, want 1", rows)
+	}
+}
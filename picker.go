@@ -0,0 +1,99 @@
+package main
+
+import (
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+var knownSites = []string{
+	"stackoverflow",
+	"superuser",
+	"serverfault",
+	"askubuntu",
+	"unix",
+	"softwareengineering",
+	"codereview",
+	"dba",
+	"security",
+	"datascience",
+	"math",
+	"mathoverflow",
+	"tex",
+	"gamedev",
+	"android",
+	"apple",
+	"english",
+}
+
+func (m *Model) ShowSitePicker() {
+	m.pickedSites = map[string]bool{}
+	for _, site := range GetConfig().FavoriteSites {
+		m.pickedSites[site] = true
+	}
+
+	m.SetSitePickerHeaders()
+	m.RefreshSitePicker()
+	m.siteTable.GotoTop()
+	m.siteTable.Focus()
+	m.textarea.Blur()
+	m.state = PickingSites
+}
+
+func (m *Model) SetSitePickerHeaders() {
+	width := m.table.Width()
+
+	m.siteTable.SetWidth(width)
+	m.siteTable.SetHeight(m.table.Height())
+	m.siteTable.SetColumns([]table.Column{
+		{Title: "Favorite", Width: int(0.2 * float32(width))},
+		{Title: "Site", Width: int(0.8 * float32(width))},
+	})
+}
+
+func (m *Model) RefreshSitePicker() {
+	rows := []table.Row{}
+	for _, site := range knownSites {
+		mark := "[ ]"
+		if m.pickedSites[site] {
+			mark = "[x]"
+		}
+		rows = append(rows, table.Row{mark, SiteDomain(site)})
+	}
+	m.siteTable.SetRows(rows)
+}
+
+func (m *Model) HandleSitePickerKey(msg tea.KeyMsg) (tea.Cmd, bool) {
+	site := knownSites[m.siteTable.Cursor()]
+
+	switch msg.String() {
+	case " ":
+		m.pickedSites[site] = !m.pickedSites[site]
+		m.RefreshSitePicker()
+		return nil, true
+	case "enter":
+		favorites := []string{site}
+		for _, known := range knownSites {
+			if m.pickedSites[known] && known != site {
+				favorites = append(favorites, known)
+			}
+		}
+
+		GetConfig().DefaultSite = site
+		GetConfig().FavoriteSites = favorites
+		m.site = site
+
+		m.siteTable.Blur()
+		m.state = WaitingForInput
+		m.textarea.Focus()
+
+		if err := SaveConfig(); err != nil {
+			return getLogCmd(T("Unable to save config: %s", err), Error), true
+		}
+		return getLogCmd(T("Default site: %s", site), Info), true
+	}
+	return nil, false
+}
+
+func sitePickerHint() string {
+	return FadedStyle.Render(T("space: toggle favorite · enter: use highlighted site as default"))
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const previewHeight = 8
+
+var enterActions = []string{"view", "browser", "preview"}
+
+func (m *Model) OpenSelectedQuestion() tea.Cmd {
+	item, ok := m.SelectedItem()
+	if !ok {
+		return getLogCmd(T("No question selected"), Warning)
+	}
+
+	m.state = DisplayingQuestionAndAnswers
+	m.table.Blur()
+
+	return tea.Batch(m.ShowQuestion(item), m.FetchMissingAnswers())
+}
+
+func (m *Model) HandleEnter() tea.Cmd {
+	switch GetConfig().EnterAction {
+	case "browser":
+		if item, ok := m.SelectedItem(); ok {
+			return openURLCmd(item.URL(m.site))
+		}
+		return getLogCmd(T("No question selected"), Warning)
+	case "preview":
+		return m.TogglePreview()
+	}
+	return m.OpenSelectedQuestion()
+}
+
+func (m *Model) TogglePreview() tea.Cmd {
+	m.preview = !m.preview
+	m.Resize(m.width, m.height)
+	return nil
+}
+
+func (m Model) PreviewView() string {
+	width := m.table.Width()
+	style := lipgloss.NewStyle().
+		Width(width).
+		Height(previewHeight - 1).
+		MaxHeight(previewHeight).
+		BorderTop(true).
+		BorderStyle(lipgloss.NormalBorder()).
+		BorderForeground(palette.Faded)
+
+	item, ok := m.SelectedItem()
+	if !ok {
+		return style.Render(FadedStyle.Render(T("No question selected")))
+	}
+
+	lines := []string{
+		AccentStyle.Render(fitCell(item.DecodedTitle(), width)),
+		FadedStyle.Render(T("score %d · %d answers · %d views", item.Score, item.AnswerCount, item.ViewCount)),
+		strings.Join(strings.Fields(StripCodeBlocks(item.BodyMarkdown)), " "),
+	}
+	if len(item.Answers) > 0 {
+		answer, accepted := BestAnswer(item.Answers)
+		label := T("Top answer: ")
+		if accepted {
+			label = T("Accepted answer: ")
+		}
+		lines = append(lines, "", PositiveScoreStyle.Render(label)+Excerpt(answer.BodyMarkdown))
+	}
+	return style.Render(strings.Join(lines, "\n"))
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/mattn/go-isatty"
+)
+
+func IsTerminal() bool {
+	return isatty.IsTerminal(os.Stdout.Fd()) || isatty.IsCygwinTerminal(os.Stdout.Fd())
+}
+
+func PrintResults(w io.Writer, query string, site string) error {
+	if strings.TrimSpace(query) == "" {
+		return fmt.Errorf("stdout is not a terminal, pass a query to print results instead: sotui <query>")
+	}
+
+	parsed, err := ParseQuery(query)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to parse query (%s), searching as plain text\n", err)
+	}
+
+	if site == "" {
+		site = GetConfig().DefaultSite
+	}
+	resp, err := Search(parsed, site, "", "", "")
+	if err != nil {
+		return err
+	}
+
+	for _, item := range resp.Items {
+		fmt.Fprintf(w, "%d\t%s\n\t%s\n", item.Score, item.DecodedTitle(), item.URL(site))
+	}
+	return nil
+}
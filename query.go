@@ -0,0 +1,113 @@
+package main
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+type Query struct {
+	Text      string
+	Title     string
+	Tagged    []string
+	NotTagged []string
+}
+
+func (q Query) IsAdvanced() bool {
+	return q.Title != "" || len(q.Tagged) > 0 || len(q.NotTagged) > 0
+}
+
+func (q Query) Params() url.Values {
+	params := url.Values{}
+	if q.Text != "" {
+		params.Set("q", q.Text)
+	}
+	if q.Title != "" {
+		params.Set("title", q.Title)
+	}
+	if len(q.Tagged) > 0 {
+		params.Set("tagged", strings.Join(q.Tagged, ";"))
+	}
+	if len(q.NotTagged) > 0 {
+		params.Set("nottagged", strings.Join(q.NotTagged, ";"))
+	}
+	return params
+}
+
+func (q Query) Relax() (Query, string, bool) {
+	if q.Title != "" {
+		relaxed := q
+		relaxed.Text = strings.TrimSpace(q.Text + " " + q.Title)
+		relaxed.Title = ""
+		return relaxed, "searching the title words as full text", true
+	}
+	if (len(q.Tagged) > 0 || len(q.NotTagged) > 0) && q.Text != "" {
+		return Query{Text: q.Text}, "dropping tags", true
+	}
+	return q, "", false
+}
+
+func (q Query) Terms() []string {
+	terms := strings.Fields(q.Title)
+	for _, word := range strings.Fields(q.Text) {
+		if !strings.HasPrefix(word, "-") {
+			terms = append(terms, word)
+		}
+	}
+	return terms
+}
+
+func ParseQuery(input string) (Query, error) {
+	query := Query{}
+	words := []string{}
+	titles := []string{}
+
+	for i := 0; i < len(input); {
+		switch {
+		case input[i] == ' ':
+			i++
+		case input[i] == '"':
+			end := strings.IndexByte(input[i+1:], '"')
+			if end < 0 {
+				return Query{Text: input}, fmt.Errorf("unterminated quote")
+			}
+			titles = append(titles, input[i+1:i+1+end])
+			i += end + 2
+		case input[i] == '[' || strings.HasPrefix(input[i:], "-["):
+			exclude := input[i] == '-'
+			if exclude {
+				i++
+			}
+
+			end := strings.IndexByte(input[i:], ']')
+			if end < 0 {
+				return Query{Text: input}, fmt.Errorf("unterminated tag")
+			}
+
+			tag := strings.TrimSpace(input[i+1 : i+end])
+			if tag == "" || strings.Contains(tag, " ") {
+				return Query{Text: input}, fmt.Errorf("invalid tag %q", tag)
+			}
+
+			if exclude {
+				query.NotTagged = append(query.NotTagged, tag)
+			} else {
+				query.Tagged = append(query.Tagged, tag)
+			}
+			i += end + 1
+		default:
+			end := strings.IndexAny(input[i:], " \"[")
+			if end < 0 {
+				end = len(input) - i
+			} else if end == 0 {
+				end = 1
+			}
+			words = append(words, input[i:i+end])
+			i += end
+		}
+	}
+
+	query.Text = strings.Join(words, " ")
+	query.Title = strings.Join(titles, " ")
+	return query, nil
+}
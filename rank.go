@@ -0,0 +1,46 @@
+package main
+
+import (
+	"math"
+	"sort"
+)
+
+func RankingWeight() float64 {
+	return math.Max(0, math.Min(1, GetConfig().RankingWeight))
+}
+
+func RankResults(items []ResponseItem) []ResponseItem {
+	weight := RankingWeight()
+	if weight == 0 || len(items) < 2 {
+		return items
+	}
+
+	maxScore := 1
+	for _, item := range items {
+		if item.Score > maxScore {
+			maxScore = item.Score
+		}
+	}
+
+	rank := make([]float64, len(items))
+	order := make([]int, len(items))
+	for i, item := range items {
+		order[i] = i
+		relevance := 1 - float64(i)/float64(len(items))
+		quality := math.Max(0, float64(item.Score)) / float64(maxScore) / 2
+		if item.AcceptedAnswerID != 0 {
+			quality += 0.5
+		}
+		rank[i] = (1-weight)*relevance + weight*quality
+	}
+
+	sort.SliceStable(order, func(i, j int) bool {
+		return rank[order[i]] > rank[order[j]]
+	})
+
+	ranked := make([]ResponseItem, len(items))
+	for i, index := range order {
+		ranked[i] = items[index]
+	}
+	return ranked
+}
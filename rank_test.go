@@ -0,0 +1,25 @@
+package main
+
+import "testing"
+
+func TestRankResultsKeepsSameIDFromDifferentSites(t *testing.T) {
+	GetConfig().RankingWeight = 1
+	t.Cleanup(func() { GetConfig().RankingWeight = 0 })
+
+	items := []ResponseItem{
+		{QuestionID: 1, Site: "stackoverflow", Score: 1},
+		{QuestionID: 1, Site: "superuser", Score: 10, AcceptedAnswerID: 2},
+		{QuestionID: 3, Site: "superuser", Score: 5},
+	}
+
+	ranked := RankResults(items)
+	want := []string{"superuser", "superuser", "stackoverflow"}
+	for i, item := range ranked {
+		if item.Site != want[i] {
+			t.Fatalf("ranked = %+v, want sites %v", ranked, want)
+		}
+	}
+	if ranked[0].QuestionID != 1 || ranked[1].QuestionID != 3 {
+		t.Errorf("ranked = %+v", ranked)
+	}
+}
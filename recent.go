@@ -0,0 +1,156 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const maxRecentQuestions = 50
+
+type RecentQuestion struct {
+	QuestionID int    `json:"question_id"`
+	Site       string `json:"site"`
+	Title      string `json:"title"`
+	Link       string `json:"link"`
+	ViewedAt   int64  `json:"viewed_at"`
+}
+
+func recentQuestionsPath() string {
+	return GetDataDir() + "/recent.json"
+}
+
+func LoadRecentQuestions() ([]RecentQuestion, error) {
+	recent := []RecentQuestion{}
+
+	data, err := os.ReadFile(recentQuestionsPath())
+	if os.IsNotExist(err) {
+		return recent, nil
+	} else if err != nil {
+		return recent, err
+	}
+
+	err = json.Unmarshal(data, &recent)
+	return recent, err
+}
+
+func WriteRecentQuestions(recent []RecentQuestion) error {
+	if err := os.MkdirAll(GetDataDir(), 0700); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(recent, "", "  ")
+	if err != nil {
+		return err
+	}
+	return WriteFileAtomic(recentQuestionsPath(), data)
+}
+
+func RecordRecentQuestion(item ResponseItem, site string) error {
+	recent, err := LoadRecentQuestions()
+	if err != nil {
+		return err
+	}
+
+	entry := RecentQuestion{
+		QuestionID: item.QuestionID,
+		Site:       site,
+		Title:      item.DecodedTitle(),
+		Link:       item.URL(site),
+		ViewedAt:   time.Now().Unix(),
+	}
+
+	updated := []RecentQuestion{entry}
+	for _, existing := range recent {
+		if existing.QuestionID != entry.QuestionID || existing.Site != entry.Site {
+			updated = append(updated, existing)
+		}
+	}
+	if len(updated) > maxRecentQuestions {
+		updated = updated[:maxRecentQuestions]
+	}
+
+	return WriteRecentQuestions(updated)
+}
+
+func recordRecentCmd(item ResponseItem, site string) tea.Cmd {
+	return writeCmd("recent questions", func() error {
+		return RecordRecentQuestion(item, site)
+	})
+}
+
+func (m *Model) ShowRecentQuestions() tea.Cmd {
+	recent, err := LoadRecentQuestions()
+	if err != nil {
+		return getLogCmd(T("Unable to read recent questions: %s", err), Error)
+	}
+	if len(recent) == 0 {
+		return getLogCmd(T("No recently viewed questions"), Warning)
+	}
+
+	m.recentQuestions = recent
+	m.SetRecentHeaders()
+	m.RefreshRecentQuestions()
+	m.recentTable.GotoTop()
+	m.recentTable.Focus()
+	m.textarea.Blur()
+	m.table.Blur()
+	m.state = DisplayingRecentQuestions
+	return nil
+}
+
+func (m Model) RecentColumnWidths() []int {
+	width := m.table.Width()
+	return []int{int(0.2 * float32(width)), int(0.6 * float32(width)), int(0.2 * float32(width))}
+}
+
+func (m *Model) SetRecentHeaders() {
+	widths := m.RecentColumnWidths()
+
+	m.recentTable.SetWidth(m.table.Width())
+	m.recentTable.SetHeight(m.table.Height())
+	m.recentTable.SetColumns([]table.Column{
+		{Title: T("Viewed"), Width: widths[0]},
+		{Title: T("Title"), Width: widths[1]},
+		{Title: T("Site"), Width: widths[2]},
+	})
+}
+
+func (m *Model) RefreshRecentQuestions() {
+	widths := m.RecentColumnWidths()
+
+	rows := []table.Row{}
+	for _, entry := range m.recentQuestions {
+		rows = append(rows, table.Row{
+			fitCell(time.Unix(entry.ViewedAt, 0).Format("2006-01-02 15:04"), widths[0]),
+			fitCell(entry.Title, widths[1]),
+			fitCell(entry.Site, widths[2]),
+		})
+	}
+	m.recentTable.SetRows(rows)
+}
+
+func (m *Model) HandleRecentQuestionKey(msg tea.KeyMsg) (tea.Cmd, bool) {
+	switch msg.String() {
+	case "enter":
+		cursor := m.recentTable.Cursor()
+		if cursor < 0 || cursor >= len(m.recentQuestions) {
+			return nil, true
+		}
+
+		entry := m.recentQuestions[cursor]
+		m.recentTable.Blur()
+		m.site = entry.Site
+		return m.StartQuestion(fmt.Sprintf("%d", entry.QuestionID)), true
+	case "backspace":
+		m.recentTable.Blur()
+		m.state = WaitingForInput
+		m.textarea.Focus()
+		return nil, true
+	}
+	return nil, false
+}
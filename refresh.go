@@ -0,0 +1,99 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type refreshMsg struct {
+	Response SEResponse
+	Err      error
+}
+
+func (m *Model) RefreshQuestion() tea.Cmd {
+	id := fmt.Sprintf("%d", m.question.QuestionID)
+	site := m.QuestionSite()
+
+	m.SaveScrollPosition()
+
+	go func() {
+		resp, err := GetQuestion(id, site)
+
+		tui.Send(refreshMsg{Response: resp, Err: err})
+	}()
+	m.StartWaiting(T("Refreshing answers..."))
+	return spinner.Tick
+}
+
+func (m *Model) LeaveQuestion() {
+	if len(m.response.Items) == 0 {
+		m.state = WaitingForInput
+		m.textarea.Focus()
+		return
+	}
+
+	m.state = DisplayingAllQuestions
+	m.table.Focus()
+}
+
+func (m *Model) ReplaceItem(item ResponseItem) {
+	for i := range m.response.Items {
+		if m.response.Items[i].Site == item.Site && m.response.Items[i].QuestionID == item.QuestionID {
+			m.response.Items[i] = item
+		}
+	}
+	for _, resp := range m.pages {
+		for i := range resp.Items {
+			if resp.Items[i].Site == item.Site && resp.Items[i].QuestionID == item.QuestionID {
+				resp.Items[i] = item
+			}
+		}
+	}
+	m.RefreshRows()
+}
+
+func (m *Model) RemoveItem(removed ResponseItem) {
+	keep := func(items []ResponseItem) []ResponseItem {
+		kept := []ResponseItem{}
+		for _, item := range items {
+			if item.Site != removed.Site || item.QuestionID != removed.QuestionID {
+				kept = append(kept, item)
+			}
+		}
+		return kept
+	}
+
+	m.response.Items = keep(m.response.Items)
+	for page, resp := range m.pages {
+		resp.Items = keep(resp.Items)
+		m.pages[page] = resp
+	}
+	m.RefreshRows()
+}
+
+func (m *Model) ApplyRefresh(msg refreshMsg) tea.Cmd {
+	m.statusMsg = ""
+
+	if msg.Err != nil {
+		m.state = DisplayingQuestionAndAnswers
+		return getLogCmd(T("Refresh failed: %s", msg.Err), Error)
+	} else if len(msg.Response.Items) == 0 {
+		m.RemoveItem(m.question)
+		m.LeaveQuestion()
+		return getLogCmd(T("Question is no longer available"), Error)
+	}
+
+	m.state = DisplayingQuestionAndAnswers
+
+	item := msg.Response.Items[0]
+	item.Site = m.question.Site
+	m.ReplaceItem(item)
+
+	m.question = item
+	m.RenderQuestion()
+	m.RestoreScrollPosition()
+
+	return getLogCmd(T("Refreshed %d answers", len(item.Answers)), Info)
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestApplyRefresh(t *testing.T) {
+	items := []ResponseItem{{QuestionID: 1, Title: "one"}, {QuestionID: 2, Title: "two"}}
+
+	tests := []struct {
+		name      string
+		msg       refreshMsg
+		wantState State
+		wantRows  int
+	}{
+		{"success", refreshMsg{Response: SEResponse{Items: []ResponseItem{{QuestionID: 1, Title: "one", Answers: []Answer{{AnswerID: 3}}}}}}, DisplayingQuestionAndAnswers, 2},
+		{"error", refreshMsg{Err: errors.New("offline")}, DisplayingQuestionAndAnswers, 2},
+		{"removed", refreshMsg{}, DisplayingAllQuestions, 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := newTestModel(DisplayingAllQuestions)
+			m.response = SEResponse{Items: append([]ResponseItem{}, items...)}
+			m.RefreshRows()
+			m.ShowQuestion(items[0])
+			m.StartWaiting("")
+
+			m.ApplyRefresh(test.msg)
+			if m.question.QuestionID != 1 {
+				t.Errorf("question = %d, want 1", m.question.QuestionID)
+			}
+			if m.state != test.wantState {
+				t.Errorf("state = %v, want %v", m.state, test.wantState)
+			}
+			if rows := len(m.table.Rows()); rows != test.wantRows {
+				t.Errorf("rows = %d, want %d", rows, test.wantRows)
+			}
+		})
+	}
+}
+
+func TestApplyRefreshKeepsSite(t *testing.T) {
+	items := []ResponseItem{{QuestionID: 1, Title: "one", Site: "superuser"}, {QuestionID: 1, Title: "other", Site: "askubuntu"}}
+
+	m := newTestModel(DisplayingAllQuestions)
+	m.response = SEResponse{Items: append([]ResponseItem{}, items...)}
+	m.pages = map[int]SEResponse{1: {Items: append([]ResponseItem{}, items...)}}
+	m.RefreshRows()
+	m.ShowQuestion(items[0])
+	m.StartWaiting("")
+
+	m.ApplyRefresh(refreshMsg{Response: SEResponse{Items: []ResponseItem{{QuestionID: 1, Title: "one", Answers: []Answer{{AnswerID: 3}}}}}})
+
+	if m.question.Site != "superuser" {
+		t.Errorf("question site = %q, want superuser", m.question.Site)
+	}
+	for name, got := range map[string][]ResponseItem{"response": m.response.Items, "page": m.pages[1].Items} {
+		if got[0].Site != "superuser" || len(got[0].Answers) != 1 {
+			t.Errorf("%s item = %+v, want refreshed superuser item", name, got[0])
+		}
+		if got[1].Title != "other" || len(got[1].Answers) != 0 {
+			t.Errorf("%s item = %+v, want untouched askubuntu item", name, got[1])
+		}
+	}
+}
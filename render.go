@@ -0,0 +1,564 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/glamour"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	positiveScoreThreshold = 5
+	wordsPerMinute         = 200
+	minWrapWidth           = 20
+	maxWrapWidth           = 500
+	maxZoom                = 4
+	collapsedTagCount      = 3
+	zoomStep               = 0.1
+	maxRenderers           = 8
+)
+
+type rendererKey struct {
+	theme string
+	width int
+}
+
+var (
+	renderers     = map[rendererKey]*glamour.TermRenderer{}
+	rendererOrder = []rendererKey{}
+)
+
+func touchRenderer(key rendererKey) {
+	for i, cached := range rendererOrder {
+		if cached == key {
+			rendererOrder = append(rendererOrder[:i], rendererOrder[i+1:]...)
+			break
+		}
+	}
+	rendererOrder = append(rendererOrder, key)
+}
+
+func cacheRenderer(key rendererKey, renderer *glamour.TermRenderer) {
+	renderers[key] = renderer
+	touchRenderer(key)
+	for len(rendererOrder) > maxRenderers {
+		delete(renderers, rendererOrder[0])
+		rendererOrder = rendererOrder[1:]
+	}
+}
+
+func RenderMarkdown(markdown string, theme string, width int) string {
+	if UseGlow() {
+		if rendered, err := RenderWithGlow(markdown, theme, width); err == nil {
+			return rendered
+		}
+	}
+
+	key := rendererKey{theme: theme, width: width}
+
+	renderer, ok := renderers[key]
+	if ok {
+		touchRenderer(key)
+	} else {
+		var err error
+		renderer, err = glamour.NewTermRenderer(markdownThemeOption(theme), glamour.WithWordWrap(width))
+		if err != nil {
+			return markdown
+		}
+		cacheRenderer(key, renderer)
+	}
+
+	rendered, err := renderer.Render(markdown)
+	if err != nil {
+		return markdown
+	}
+	return rendered
+}
+
+func RenderWithCodeWidth(markdown string, theme string, width int, codeWidth int) string {
+	if codeWidth <= width {
+		return RenderMarkdown(markdown, theme, width)
+	}
+
+	segments := SplitCodeBlocks(markdown)
+	hasCode := false
+	for _, segment := range segments {
+		hasCode = hasCode || segment.Code
+	}
+	if !hasCode {
+		return RenderMarkdown(markdown, theme, width)
+	}
+
+	rendered := ""
+	for _, segment := range segments {
+		if segment.Code {
+			rendered += RenderMarkdown(JoinSegments([]MarkdownSegment{segment}), theme, codeWidth)
+		} else if strings.TrimSpace(segment.Text) != "" {
+			rendered += RenderMarkdown(segment.Text, theme, width)
+		}
+	}
+	return rendered
+}
+
+func (m Model) CodeWidth() int {
+	width := m.viewport.Width
+	if codeWidth := GetConfig().CodeWrapWidth; ValidWrapWidth(codeWidth) && codeWidth < width {
+		width = codeWidth
+	}
+	return width
+}
+
+func ValidWrapWidth(width int) bool {
+	return width >= minWrapWidth && width <= maxWrapWidth
+}
+
+func (m Model) ContentWidth() int {
+	width := m.viewport.Width
+	if wrapWidth := GetConfig().WrapWidth; ValidWrapWidth(wrapWidth) {
+		width = wrapWidth
+	}
+
+	zoomed := int(float64(width) * (1 - zoomStep*float64(m.zoom)))
+	if zoomed > m.viewport.Width && m.zoom < 0 {
+		zoomed = m.viewport.Width
+	}
+	if zoomed < minWrapWidth {
+		zoomed = minWrapWidth
+	}
+	return zoomed
+}
+
+func (m Model) ZoomPadding() int {
+	if m.zoom <= 0 {
+		return 0
+	}
+
+	padding := (m.viewport.Width - m.ContentWidth()) / 2
+	if padding < 0 {
+		return 0
+	}
+	return padding
+}
+
+func (m *Model) Zoom(delta int) tea.Cmd {
+	zoom := m.zoom + delta
+	if zoom > maxZoom || zoom < -maxZoom {
+		return getLogCmd(T("Zoom limit reached"), Warning)
+	}
+
+	m.zoom = zoom
+	m.RenderQuestion()
+	return getLogCmd(T("Zoom: %+d", m.zoom), Info)
+}
+
+func (m *Model) ShowQuestion(row ResponseItem) tea.Cmd {
+	m.question = row
+	m.ClearFind()
+	m.showComments = false
+	m.showAllAnswers = false
+	m.markedAnswers = nil
+	m.RenderQuestion()
+	m.RestoreScrollPosition()
+
+	return recordRecentCmd(row, m.QuestionSite())
+}
+
+// QuestionSite is the site of the open question, which differs from m.site
+// for results merged from several sites.
+func (m Model) QuestionSite() string {
+	if m.question.Site != "" {
+		return m.question.Site
+	}
+	return m.site
+}
+
+func (m *Model) RenderQuestion() {
+	if m.plainText {
+		m.RenderPlainQuestion()
+		return
+	}
+
+	row := m.question
+	width := m.ContentWidth()
+	answerWidth := width - BorderStyle.GetHorizontalFrameSize()
+	codeWidth := m.CodeWidth() - m.ZoomPadding()
+	terms := m.HighlightPattern()
+	if m.findPattern != nil {
+		terms = m.findPattern
+	}
+
+	question := RenderMarkdown(fmt.Sprintf("# %s", row.DecodedTitle()), m.theme, width)
+	question += questionHeader(row, m.showAllTags) + "\n"
+	if !m.hideQuestion {
+		body := PrepareMath(row.BodyMarkdown)
+		if m.lineNumbers {
+			body = NumberCodeLines(body)
+		}
+		question += RenderWithCodeWidth(HighlightTerms(body, terms), m.theme, width, codeWidth)
+		if m.showComments {
+			question += m.QuestionCommentsView(width)
+		}
+	}
+
+	content := question
+	if GetConfig().Density != "compact" {
+		content += "\n\n"
+	}
+	m.dividerOffset = strings.Count(content, "\n")
+	content += m.AnswersDivider(width) + "\n"
+	if GetConfig().Density != "compact" {
+		content += "\n"
+	}
+
+	m.answerOffsets = []int{}
+	m.renderedAnswers = m.VisibleAnswers()
+
+	for _, answer := range m.renderedAnswers {
+		m.answerOffsets = append(m.answerOffsets, strings.Count(content, "\n"))
+
+		body := answer.BodyMarkdown
+		if m.proseOnly {
+			body = StripCodeBlocks(body)
+		}
+		body = PrepareMath(body)
+		if m.lineNumbers {
+			body = NumberCodeLines(body)
+		}
+
+		rendered := RenderWithCodeWidth(HighlightTerms(body, terms), m.theme, answerWidth, codeWidth-BorderStyle.GetHorizontalFrameSize())
+		header := answerHeader(answer, m.answerMarks[answer.AnswerID])
+		if HasWideTable(answer.BodyMarkdown, answerWidth) {
+			header += " " + FadedStyle.Render(T("(wide table, W for fullscreen)"))
+		}
+		if m.IsMarked(answer) {
+			header += " " + AccentStyle.Render(T("[compare]"))
+		}
+
+		content += BorderStyle.Render(fmt.Sprintf("%s\n%s\n\n", header, rendered))
+	}
+
+	if hidden := m.HiddenByScore(); hidden > 0 && !m.acceptedOnly {
+		content += "\n" + lipgloss.NewStyle().MarginLeft(2).Render(FadedStyle.Render(T("%d answers hidden below score %d", hidden, m.minScore))) + "\n"
+	} else if hidden := len(row.Answers) - len(m.renderedAnswers); hidden > 0 && !m.showAllAnswers && !m.acceptedOnly {
+		content += "\n" + lipgloss.NewStyle().MarginLeft(2).Render(FadedStyle.Render(T("%d more answers — press a to load", hidden))) + "\n"
+	}
+
+	m.renderedContent = lipgloss.NewStyle().MarginLeft(m.ZoomPadding()).Render(content)
+	m.findMatches = MatchingLines(m.renderedContent, m.findPattern)
+	m.viewport.SetContent(m.renderedContent)
+}
+
+func (m *Model) RenderPlainQuestion() {
+	row := m.question
+
+	content := row.DecodedTitle() + "\n"
+	content += T("score %d · %d answers · %d views", row.Score, row.AnswerCount, row.ViewCount) + "\n"
+	content += row.URL(m.QuestionSite()) + "\n\n"
+	if !m.hideQuestion {
+		content += strings.TrimSpace(row.BodyMarkdown) + "\n\n"
+	}
+
+	m.dividerOffset = strings.Count(content, "\n")
+	content += strings.Repeat("-", 20) + "\n\n"
+
+	m.answerOffsets = []int{}
+	m.renderedAnswers = m.VisibleAnswers()
+
+	for _, answer := range m.renderedAnswers {
+		m.answerOffsets = append(m.answerOffsets, strings.Count(content, "\n"))
+
+		body := answer.BodyMarkdown
+		if m.proseOnly {
+			body = StripCodeBlocks(body)
+		}
+
+		header := T("Answer · score %d", answer.Score)
+		if answer.IsAccepted {
+			header += " · " + T("accepted")
+		}
+		content += header + "\n\n" + strings.TrimSpace(body) + "\n\n"
+	}
+
+	m.renderedContent = content
+	m.findMatches = MatchingLines(m.renderedContent, m.findPattern)
+	m.viewport.SetContent(content)
+}
+
+func (m Model) HighlightPattern() *regexp.Regexp {
+	if !m.highlightTerms || m.query == "" {
+		return nil
+	}
+
+	query, err := ParseQuery(m.query)
+	if err != nil {
+		return nil
+	}
+	return TermsPattern(query.Terms())
+}
+
+func (m Model) AnswersDivider(width int) string {
+	label := " " + T("%d answers · sorted by %s", len(m.question.Answers), T(m.answerSort)) + " "
+	if len(m.question.Answers) == 1 {
+		label = " " + T("1 answer · sorted by %s", T(m.answerSort)) + " "
+	}
+	if m.acceptedOnly {
+		label = " " + T("%d answers · accepted only", len(m.question.Answers)) + " "
+	}
+
+	side := (width - lipgloss.Width(label)) / 2
+	if side < 2 {
+		side = 2
+	}
+
+	return lipgloss.NewStyle().Foreground(palette.Positive).Render(strings.Repeat("─", side) + label + strings.Repeat("─", side))
+}
+
+func (m Model) QuestionView() string {
+	view := m.viewport.View()
+	if m.finding || m.findPattern != nil {
+		if i := strings.LastIndex(view, "\n"); i >= 0 {
+			view = view[:i]
+		}
+		view += "\n" + m.FindView()
+	}
+	if m.viewport.YOffset <= m.dividerOffset || m.plainText {
+		return view
+	}
+
+	if _, rest, ok := strings.Cut(view, "\n"); ok {
+		return strings.Repeat(" ", m.ZoomPadding()) + m.AnswersDivider(m.ContentWidth()) + "\n" + rest
+	}
+	return view
+}
+
+func (m Model) VisibleAnswers() []Answer {
+	answers := make([]Answer, len(m.question.Answers))
+	copy(answers, m.question.Answers)
+
+	sort.SliceStable(answers, func(i, j int) bool {
+		switch m.answerSort {
+		case "votes":
+			return answers[i].Score > answers[j].Score
+		case "recent":
+			return answers[i].CreationDate > answers[j].CreationDate
+		}
+
+		if answers[i].IsAccepted != answers[j].IsAccepted {
+			return answers[i].IsAccepted
+		}
+		return answers[i].Score > answers[j].Score
+	})
+
+	if m.scoreFilter {
+		kept := []Answer{}
+		for _, answer := range answers {
+			if answer.IsAccepted || answer.Score >= m.minScore {
+				kept = append(kept, answer)
+			}
+		}
+		answers = kept
+	}
+
+	if m.acceptedOnly && len(answers) > 0 {
+		best, _ := BestAnswer(answers)
+		return []Answer{best}
+	}
+
+	if limit := GetConfig().AnswerLimit; limit > 0 && !m.showAllAnswers && len(answers) > limit {
+		answers = answers[:limit]
+	}
+	return answers
+}
+
+func (m Model) HiddenByScore() int {
+	if !m.scoreFilter {
+		return 0
+	}
+
+	hidden := 0
+	for _, answer := range m.question.Answers {
+		if !answer.IsAccepted && answer.Score < m.minScore {
+			hidden++
+		}
+	}
+	return hidden
+}
+
+func (m *Model) AdjustMinScore(delta int) tea.Cmd {
+	if !m.scoreFilter {
+		if delta < 0 {
+			return nil
+		}
+		m.scoreFilter = true
+		m.minScore = 0
+	} else {
+		m.minScore += delta
+	}
+
+	lowest := 0
+	for i, answer := range m.question.Answers {
+		if i == 0 || answer.Score < lowest {
+			lowest = answer.Score
+		}
+	}
+	if m.minScore <= lowest && delta < 0 {
+		m.scoreFilter = false
+	}
+
+	m.RenderQuestion()
+	m.viewport.GotoTop()
+	if !m.scoreFilter {
+		return getLogCmd(T("Showing answers of any score"), Info)
+	}
+	return getLogCmd(T("Hiding answers below score %d", m.minScore), Info)
+}
+
+var answerSorts = []string{"accepted", "votes", "recent"}
+
+func NextAnswerSort(name string) string {
+	for i, sortName := range answerSorts {
+		if sortName == name {
+			return answerSorts[(i+1)%len(answerSorts)]
+		}
+	}
+	return answerSorts[0]
+}
+
+func (m *Model) ToggleQuestionBody() {
+	m.hideQuestion = !m.hideQuestion
+	m.RenderKeepingAnswer()
+}
+
+func (m *Model) RenderKeepingAnswer() {
+	_, index, ok := m.CurrentAnswer()
+	offset := 0
+	if ok && index < len(m.answerOffsets) {
+		offset = m.viewport.YOffset - m.answerOffsets[index]
+	}
+
+	m.RenderQuestion()
+
+	if ok && index < len(m.answerOffsets) {
+		m.viewport.SetYOffset(m.answerOffsets[index] + offset)
+	}
+}
+
+func (m Model) CurrentAnswer() (Answer, int, bool) {
+	index := -1
+	for i, offset := range m.answerOffsets {
+		if offset <= m.viewport.YOffset+m.viewport.Height/2 {
+			index = i
+		}
+	}
+
+	if index < 0 || index >= len(m.renderedAnswers) {
+		if len(m.renderedAnswers) == 0 {
+			return Answer{}, -1, false
+		}
+		index = 0
+	}
+	return m.renderedAnswers[index], index, true
+}
+
+func (m *Model) FocusAnswer(delta int) {
+	if len(m.answerOffsets) == 0 {
+		return
+	}
+
+	index := 0
+	for i, offset := range m.answerOffsets {
+		if offset <= m.viewport.YOffset {
+			index = i
+		}
+	}
+
+	if m.viewport.YOffset < m.answerOffsets[0] && delta > 0 {
+		index = 0
+	} else {
+		index += delta
+	}
+
+	if index < 0 {
+		m.viewport.GotoTop()
+		return
+	}
+	if index >= len(m.answerOffsets) {
+		index = len(m.answerOffsets) - 1
+	}
+	m.viewport.SetYOffset(m.answerOffsets[index])
+}
+
+func questionHeader(row ResponseItem, allTags bool) string {
+	header := AccentStyle.Render(fmt.Sprintf("▲ %d", row.Score)) + " " +
+		FadedStyle.Render(T("· %d answers · %d views", row.AnswerCount, row.ViewCount))
+	if bounty := row.BountyLabel(); bounty != "" {
+		header += " " + NeutralScoreStyle.Render(T("%s bounty", bounty))
+		if row.BountyClosesDate > 0 {
+			header += FadedStyle.Render(T(" · closes %s", time.Unix(int64(row.BountyClosesDate), 0).Format("2006-01-02")))
+		}
+	}
+
+	if len(row.Tags) > 0 {
+		tags := []string{}
+		for i, tag := range row.Tags {
+			if !allTags && i == collapsedTagCount {
+				tags = append(tags, FadedStyle.Render(T("+%d more (T)", len(row.Tags)-collapsedTagCount)))
+				break
+			}
+			tags = append(tags, AccentStyle.Render("["+tag+"]"))
+		}
+		header += "\n" + strings.Join(tags, " ")
+	}
+
+	return lipgloss.NewStyle().MarginLeft(2).Render(header)
+}
+
+func ReadTime(markdown string) string {
+	minutes := len(strings.Fields(markdown)) / wordsPerMinute
+	if minutes < 1 {
+		return T("<1 min read")
+	}
+	return T("%d min read", minutes)
+}
+
+func answerHeader(answer Answer, mark AnswerMark) string {
+	scoreStyle := NeutralScoreStyle
+	if answer.Score >= positiveScoreThreshold {
+		scoreStyle = PositiveScoreStyle
+	} else if answer.Score < 0 {
+		scoreStyle = NegativeScoreStyle
+	}
+
+	header := scoreStyle.Render(fmt.Sprintf("▲ %d ▼", answer.Score))
+	if answer.IsAccepted {
+		header += " " + PositiveScoreStyle.Render(T("✓ accepted"))
+	}
+
+	if mark := answerMarkView(mark); mark != "" {
+		header += " " + mark
+	}
+
+	header += " " + FadedStyle.Render(ReadTime(answer.BodyMarkdown))
+
+	if answer.CreationDate != 0 {
+		created := time.Unix(int64(answer.CreationDate), 0)
+		header += " " + FadedStyle.Render(created.Format("2006-01-02"))
+
+		if answer.LastEditDate > answer.CreationDate {
+			edited := time.Unix(int64(answer.LastEditDate), 0)
+			header += " " + FadedStyle.Render(T("(edited %s)", edited.Format("2006-01-02")))
+		}
+
+		years := GetConfig().OutdatedAnswerYears
+		if years > 0 && created.Before(time.Now().AddDate(-years, 0, 0)) {
+			header += " " + FadedStyle.Render(T("(possibly outdated)"))
+		}
+	}
+
+	return header
+}
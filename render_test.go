@@ -0,0 +1,20 @@
+package main
+
+import "testing"
+
+func TestRendererCacheIsBounded(t *testing.T) {
+	for width := minWrapWidth; width < minWrapWidth+maxRenderers*2; width++ {
+		RenderMarkdown("body", "dark", width)
+		RenderMarkdown("body", "dark", minWrapWidth)
+	}
+
+	if len(renderers) != maxRenderers || len(rendererOrder) != maxRenderers {
+		t.Errorf("cache has %d renderers and %d keys, want %d", len(renderers), len(rendererOrder), maxRenderers)
+	}
+	if _, ok := renderers[rendererKey{theme: "dark", width: minWrapWidth}]; !ok {
+		t.Error("recently used renderer was evicted")
+	}
+	if _, ok := renderers[rendererKey{theme: "dark", width: minWrapWidth + 1}]; ok {
+		t.Error("least recently used renderer was kept")
+	}
+}
@@ -0,0 +1,22 @@
+package main
+
+import "fmt"
+
+func TrimResults(items []ResponseItem, max int) []ResponseItem {
+	if max <= 0 || len(items) <= max {
+		return items
+	}
+	return items[len(items)-max:]
+}
+
+func DedupeResults(items []ResponseItem, seen map[string]bool) []ResponseItem {
+	unique := []ResponseItem{}
+	for _, item := range items {
+		key := fmt.Sprintf("%s/%d", item.Site, item.QuestionID)
+		if !seen[key] {
+			seen[key] = true
+			unique = append(unique, item)
+		}
+	}
+	return unique
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+)
+
+func benchmarkItems(n int) []ResponseItem {
+	items := make([]ResponseItem, n)
+	for i := range items {
+		items[i] = ResponseItem{
+			QuestionID:  i + 1,
+			Title:       fmt.Sprintf("How do I fix error number %d when building a large project?", i),
+			Score:       i % 50,
+			AnswerCount: i % 7,
+			ViewCount:   i * 13,
+		}
+	}
+	return items
+}
+
+func TestTrimResultsKeepsNewest(t *testing.T) {
+	items := TrimResults(benchmarkItems(1000), 500)
+	if len(items) != 500 || items[0].QuestionID != 501 {
+		t.Errorf("kept %d items starting at %d, want 500 starting at 501", len(items), items[0].QuestionID)
+	}
+}
+
+func BenchmarkToRows(b *testing.B) {
+	resp := SEResponse{Items: benchmarkItems(1000)}
+	widths := []int{8, 60, 6, 8, 8}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		resp.ToRows(widths)
+	}
+}
+
+func BenchmarkTableView(b *testing.B) {
+	m := newTestModel(DisplayingAllQuestions)
+	m.response = SEResponse{Items: benchmarkItems(1000)}
+	m.RefreshRows()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m.table.MoveDown(1)
+		_ = m.table.View()
+	}
+}
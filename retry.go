@@ -0,0 +1,75 @@
+package main
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type RequestKind int
+
+const (
+	SearchRequest RequestKind = iota
+	QuestionRequest
+	TrendingRequest
+)
+
+type Request struct {
+	Kind  RequestKind
+	Query string
+	Site  string
+}
+
+type requestFailedMsg struct {
+	Request Request
+	Err     error
+}
+
+func IsNetworkError(err error) bool {
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+func IsOnline() bool {
+	client := http.Client{Timeout: 3 * time.Second}
+
+	resp, err := client.Head(baseApiURL)
+	if err != nil {
+		return false
+	}
+	resp.Body.Close()
+	return true
+}
+
+func (m *Model) CheckConnectivity() {
+	if m.lastFailed == nil || m.checkingConnection || !IsNetworkError(m.lastFailed.Err) {
+		return
+	}
+
+	m.checkingConnection = true
+	go func() {
+		tui.Send(connectivityMsg(IsOnline()))
+	}()
+}
+
+func (m *Model) RetryLastRequest() tea.Cmd {
+	if m.lastFailed == nil {
+		return getLogCmd(T("Nothing to retry"), Warning)
+	}
+
+	request := m.lastFailed.Request
+	m.lastFailed = nil
+	m.site = request.Site
+	m.textarea.Blur()
+
+	switch request.Kind {
+	case QuestionRequest:
+		return m.StartQuestion(request.Query)
+	case TrendingRequest:
+		return m.StartTrending()
+	}
+	return m.StartSearch(request.Query)
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var (
+	numericEntity  = regexp.MustCompile(`&#([xX][0-9a-fA-F]+|[0-9]+);`)
+	escapeSequence = regexp.MustCompile("(\x1b\\[|\u009b)[0-?]*[ -/]*[@-~]|(\x1b\\]|\u009d)[^\x07\x1b\u009c]*(\x07|\x1b\\\\|\u009c)?|\x1b[ -/]*[0-~]")
+)
+
+func isUnsafeRune(r rune) bool {
+	return unicode.IsControl(r) && r != '\n' && r != '\t'
+}
+
+func Sanitize(text string) string {
+	text = escapeSequence.ReplaceAllString(text, "")
+	text = strings.Map(func(r rune) rune {
+		if r == '\r' {
+			return -1
+		}
+		if isUnsafeRune(r) {
+			return unicode.ReplacementChar
+		}
+		return r
+	}, text)
+
+	return numericEntity.ReplaceAllStringFunc(text, func(entity string) string {
+		digits := entity[2 : len(entity)-1]
+		base := 10
+		if digits[0] == 'x' || digits[0] == 'X' {
+			digits, base = digits[1:], 16
+		}
+		code, err := strconv.ParseInt(digits, base, 32)
+		if err == nil && isUnsafeRune(rune(code)) {
+			return string(unicode.ReplacementChar)
+		}
+		return entity
+	})
+}
+
+func (response *SEResponse) Sanitize() {
+	for i := range response.Items {
+		item := &response.Items[i]
+		item.Title = Sanitize(item.Title)
+		item.BodyMarkdown = Sanitize(item.BodyMarkdown)
+		item.Link = Sanitize(item.Link)
+		for j := range item.Tags {
+			item.Tags[j] = Sanitize(item.Tags[j])
+		}
+		for j := range item.Answers {
+			item.Answers[j].BodyMarkdown = Sanitize(item.Answers[j].BodyMarkdown)
+			item.Answers[j].Owner.DisplayName = Sanitize(item.Answers[j].Owner.DisplayName)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package main
+
+import "testing"
+
+func TestSanitizeStripsEscapeSequences(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"csi color", "\x1b[31mred\x1b[0m title", "red title"},
+		{"csi cursor", "clear\x1b[2J\x1b[Hscreen", "clearscreen"},
+		{"8-bit csi", "a\u009b1;2Hb", "ab"},
+		{"osc title bel", "\x1b]0;pwned\x07body", "body"},
+		{"osc hyperlink st", "\x1b]8;;https://evil.example\x1b\\link\x1b]8;;\x1b\\", "link"},
+		{"unterminated osc", "before\x1b]2;title", "before"},
+		{"bare escape", "x\x1bcy", "xy"},
+		{"keeps newlines and tabs", "line\n\tindented\r\n", "line\n\tindented\n"},
+		{"other controls", "bell\x07 back\x08", "bell� back�"},
+		{"control entity", "esc &#27;[31m &#x1b; &#60;", "esc �[31m � &#60;"},
+	}
+
+	for _, test := range tests {
+		if got := Sanitize(test.in); got != test.want {
+			t.Errorf("%s: Sanitize(%q) = %q, want %q", test.name, test.in, got, test.want)
+		}
+	}
+}
+
+func TestSanitizeResponse(t *testing.T) {
+	resp, err := DecodeResponse([]byte(`{"items":[{"title":"\u001b[1mBold\u001b[0m","body_markdown":"\u001b]0;x\u0007text","answers":[{"body_markdown":"\u001b[2Kanswer"}]}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	item := resp.Items[0]
+	if item.Title != "Bold" || item.BodyMarkdown != "text" || item.Answers[0].BodyMarkdown != "answer" {
+		t.Errorf("item = %q %q %q", item.Title, item.BodyMarkdown, item.Answers[0].BodyMarkdown)
+	}
+}
@@ -0,0 +1,234 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const excerptLength = 120
+
+type SavedAnswer struct {
+	AnswerID   int    `json:"answer_id"`
+	QuestionID int    `json:"question_id"`
+	Site       string `json:"site"`
+	Title      string `json:"title"`
+	Link       string `json:"link"`
+	Excerpt    string `json:"excerpt"`
+	SavedAt    int64  `json:"saved_at"`
+}
+
+func savedAnswersPath() string {
+	return GetDataDir() + "/saved_answers.json"
+}
+
+func LoadSavedAnswers() ([]SavedAnswer, error) {
+	saved := []SavedAnswer{}
+
+	data, err := os.ReadFile(savedAnswersPath())
+	if os.IsNotExist(err) {
+		return saved, nil
+	} else if err != nil {
+		return saved, err
+	}
+
+	err = json.Unmarshal(data, &saved)
+	return saved, err
+}
+
+func WriteSavedAnswers(saved []SavedAnswer) error {
+	if err := os.MkdirAll(GetDataDir(), 0700); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(saved, "", "  ")
+	if err != nil {
+		return err
+	}
+	return WriteFileAtomic(savedAnswersPath(), data)
+}
+
+func Excerpt(markdown string) string {
+	prose := StripCodeBlocks(markdown)
+	text := strings.Join(strings.Fields(prose), " ")
+	if len([]rune(text)) > excerptLength {
+		return string([]rune(text)[:excerptLength]) + "…"
+	}
+	return text
+}
+
+func (m *Model) ToggleSavedAnswer() tea.Cmd {
+	answer, _, ok := m.CurrentAnswer()
+	if !ok {
+		return getLogCmd(T("No answer to save"), Warning)
+	}
+
+	saved, err := LoadSavedAnswers()
+	if err != nil {
+		return getLogCmd(T("Unable to read saved answers: %s", err), Error)
+	}
+
+	for i, entry := range saved {
+		if entry.AnswerID == answer.AnswerID {
+			saved = append(saved[:i], saved[i+1:]...)
+			if err := WriteSavedAnswers(saved); err != nil {
+				return getLogCmd(T("Unable to save answers: %s", err), Error)
+			}
+			return getLogCmd(T("Removed answer from saved answers"), Info)
+		}
+	}
+
+	saved = append(saved, SavedAnswer{
+		AnswerID:   answer.AnswerID,
+		QuestionID: m.question.QuestionID,
+		Site:       m.QuestionSite(),
+		Title:      m.question.DecodedTitle(),
+		Link:       AnswerURL(m.QuestionSite(), m.question.QuestionID, answer.AnswerID),
+		Excerpt:    Excerpt(answer.BodyMarkdown),
+		SavedAt:    time.Now().Unix(),
+	})
+	if err := WriteSavedAnswers(saved); err != nil {
+		return getLogCmd(T("Unable to save answers: %s", err), Error)
+	}
+	return getLogCmd(T("Saved answer for later"), Info)
+}
+
+func (m *Model) ShowSavedAnswers() tea.Cmd {
+	saved, err := LoadSavedAnswers()
+	if err != nil {
+		return getLogCmd(T("Unable to read saved answers: %s", err), Error)
+	}
+	if len(saved) == 0 {
+		return getLogCmd(T("No saved answers yet, press s on an answer to save it"), Warning)
+	}
+
+	m.savedAnswers = saved
+	m.SetSavedAnswerHeaders()
+	m.RefreshSavedAnswers()
+	m.savedTable.GotoTop()
+	m.savedTable.Focus()
+	m.textarea.Blur()
+	m.table.Blur()
+	m.state = DisplayingSavedAnswers
+	return nil
+}
+
+func (m Model) SavedAnswerColumnWidths() []int {
+	width := m.table.Width()
+	return []int{int(0.4 * float32(width)), int(0.6 * float32(width))}
+}
+
+func (m *Model) SetSavedAnswerHeaders() {
+	widths := m.SavedAnswerColumnWidths()
+
+	m.savedTable.SetWidth(m.table.Width())
+	m.savedTable.SetHeight(m.table.Height())
+	m.savedTable.SetColumns([]table.Column{
+		{Title: T("Question"), Width: widths[0]},
+		{Title: T("Excerpt"), Width: widths[1]},
+	})
+}
+
+func (m *Model) RefreshSavedAnswers() {
+	rows := []table.Row{}
+	for _, entry := range m.savedAnswers {
+		rows = append(rows, table.Row{
+			fitCell(entry.Title, m.SavedAnswerColumnWidths()[0]),
+			fitCell(entry.Excerpt, m.SavedAnswerColumnWidths()[1]),
+		})
+	}
+	m.savedTable.SetRows(rows)
+}
+
+func (m *Model) HideSavedAnswers() {
+	m.savedTable.Blur()
+	m.state = WaitingForInput
+	m.textarea.Focus()
+}
+
+func (m Model) SelectedSavedAnswer() (SavedAnswer, int, bool) {
+	cursor := m.savedTable.Cursor()
+	if cursor < 0 || cursor >= len(m.savedAnswers) {
+		return SavedAnswer{}, -1, false
+	}
+	return m.savedAnswers[cursor], cursor, true
+}
+
+func (m *Model) HandleSavedAnswerKey(msg tea.KeyMsg) (tea.Cmd, bool) {
+	switch msg.String() {
+	case "enter":
+		entry, _, ok := m.SelectedSavedAnswer()
+		if !ok {
+			return nil, true
+		}
+
+		m.savedTable.Blur()
+		m.site = entry.Site
+		m.pendingAnswerID = entry.AnswerID
+		return m.StartQuestion(fmt.Sprintf("%d", entry.QuestionID)), true
+	case "o":
+		if entry, _, ok := m.SelectedSavedAnswer(); ok {
+			return openURLCmd(entry.Link), true
+		}
+		return nil, true
+	case "x", "delete":
+		entry, index, ok := m.SelectedSavedAnswer()
+		if !ok {
+			return nil, true
+		}
+
+		return m.ConfirmDestructive(T("Remove the saved answer for %q?", entry.Title), func(m *Model) tea.Cmd {
+			return m.RemoveSavedAnswer(index)
+		}), true
+	case "backspace":
+		m.HideSavedAnswers()
+		return nil, true
+	}
+	return nil, false
+}
+
+func (m *Model) RemoveSavedAnswer(index int) tea.Cmd {
+	snapshot := make([]SavedAnswer, len(m.savedAnswers))
+	copy(snapshot, m.savedAnswers)
+
+	m.savedAnswers = append(m.savedAnswers[:index], m.savedAnswers[index+1:]...)
+	if err := WriteSavedAnswers(m.savedAnswers); err != nil {
+		return getLogCmd(T("Unable to save answers: %s", err), Error)
+	}
+
+	m.RefreshSavedAnswers()
+
+	return m.OfferUndo(T("Removed answer from saved answers"), func(m *Model) tea.Cmd {
+		if err := WriteSavedAnswers(snapshot); err != nil {
+			return getLogCmd(T("Unable to save answers: %s", err), Error)
+		}
+
+		m.savedAnswers = snapshot
+		m.RefreshSavedAnswers()
+		return getLogCmd(T("Restored saved answer"), Info)
+	})
+}
+
+func (m *Model) HideEmptySavedAnswers() {
+	if m.state == DisplayingSavedAnswers && len(m.savedAnswers) == 0 {
+		m.HideSavedAnswers()
+	}
+}
+
+func (m *Model) FocusPendingAnswer() {
+	if m.pendingAnswerID == 0 {
+		return
+	}
+
+	for i, answer := range m.renderedAnswers {
+		if answer.AnswerID == m.pendingAnswerID && i < len(m.answerOffsets) {
+			m.viewport.SetYOffset(m.answerOffsets[i])
+		}
+	}
+	m.pendingAnswerID = 0
+}
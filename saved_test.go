@@ -0,0 +1,48 @@
+package main
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestUndoRemovingOnlySavedAnswer(t *testing.T) {
+	saved := []SavedAnswer{{QuestionID: 1, AnswerID: 2, Title: "question"}}
+	if err := WriteSavedAnswers(saved); err != nil {
+		t.Fatal(err)
+	}
+
+	m := newTestModel(WaitingForInput)
+	m.ShowSavedAnswers()
+	m.RemoveSavedAnswer(0)
+	if len(m.savedAnswers) != 0 || m.state != DisplayingSavedAnswers {
+		t.Fatalf("after remove: %d saved, state %v", len(m.savedAnswers), m.state)
+	}
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("u")})
+	m = updated.(Model)
+	if len(m.savedAnswers) != 1 {
+		t.Errorf("after undo: %d saved, want 1", len(m.savedAnswers))
+	}
+	if stored, _ := LoadSavedAnswers(); len(stored) != 1 {
+		t.Errorf("after undo: %d stored, want 1", len(stored))
+	}
+	if m.textarea.Value() != "" {
+		t.Errorf("undo key reached the textarea: %q", m.textarea.Value())
+	}
+}
+
+func TestEmptySavedAnswersCloseWhenUndoExpires(t *testing.T) {
+	if err := WriteSavedAnswers([]SavedAnswer{{QuestionID: 1, AnswerID: 2}}); err != nil {
+		t.Fatal(err)
+	}
+
+	m := newTestModel(WaitingForInput)
+	m.ShowSavedAnswers()
+	m.RemoveSavedAnswer(0)
+
+	updated, _ := m.Update(undoExpiredMsg(m.undoID))
+	if state := updated.(Model).state; state != WaitingForInput {
+		t.Errorf("state = %v, want %v", state, WaitingForInput)
+	}
+}
@@ -0,0 +1,28 @@
+package main
+
+const maxScrollPositions = 100
+
+func (m *Model) SaveScrollPosition() {
+	id := m.question.QuestionID
+	if id == 0 {
+		return
+	}
+
+	if _, ok := m.scrollPositions[id]; !ok {
+		m.scrollOrder = append(m.scrollOrder, id)
+	}
+	m.scrollPositions[id] = m.viewport.YOffset
+
+	for len(m.scrollOrder) > maxScrollPositions {
+		delete(m.scrollPositions, m.scrollOrder[0])
+		m.scrollOrder = m.scrollOrder[1:]
+	}
+}
+
+func (m *Model) RestoreScrollPosition() {
+	if offset, ok := m.scrollPositions[m.question.QuestionID]; ok {
+		m.viewport.SetYOffset(offset)
+	} else {
+		m.viewport.GotoTop()
+	}
+}
@@ -1,22 +1,162 @@
 package main
 
 import (
+	"encoding/json"
+	"fmt"
+	"net/url"
 	"regexp"
+	"strings"
+	"sync"
 
 	googlesearch "github.com/rocketlaunchr/google-search"
 )
 
-func Search(query string, site string, sort string, order string, filter string) SEResponse {
-	searchResults, err := googlesearch.Search(nil, query+" site:stackoverflow.com") //TODO: Fix this so that it works for all sites. Note: site is NOT the full domain
+const (
+	defaultSite  = "stackoverflow"
+	defaultSort  = "votes"
+	defaultOrder = "desc"
+
+	// fallbackFilter is a built-in filter that adds bodies to the default
+	// fields, used when the default filter cannot be created.
+	fallbackFilter = "withbody"
+)
+
+// filterFields is the contents of the default filter: exactly the fields
+// decoded into SEResponse, ResponseItem, Answer, Comment and Owner.
+// Questions and answers carry both body and body_markdown so the
+// question view needs no second request.
+var filterFields = []string{
+	".error_id", ".error_message", ".error_name",
+	".has_more", ".items", ".quota_max", ".quota_remaining",
+
+	"question.accepted_answer_id", "question.answer_count", "question.answers",
+	"question.body", "question.body_markdown",
+	"question.bounty_amount", "question.bounty_closes_date",
+	"question.creation_date", "question.last_edit_date", "question.link",
+	"question.owner", "question.question_id", "question.score",
+	"question.tags", "question.title", "question.view_count",
+
+	"answer.answer_id", "answer.body", "answer.body_markdown",
+	"answer.comment_count", "answer.comments",
+	"answer.creation_date", "answer.is_accepted", "answer.last_edit_date",
+	"answer.owner", "answer.question_id", "answer.score",
+
+	"comment.body", "comment.comment_id", "comment.owner",
+	"comment.post_id", "comment.score",
+
+	"shallow_user.display_name",
+}
+
+var (
+	defaultFilter   string
+	defaultFilterMu sync.Mutex
+)
+
+// CreateFilter asks the API for a filter id holding exactly the given
+// fields. Filters never change, so APIFilter keeps the first id it gets.
+func CreateFilter(fields []string) (string, error) {
+	respBytes, err := FetchAPI(RequestOptions{
+		Path: "filters/create",
+		Params: url.Values{
+			"include": {strings.Join(fields, ";")},
+			"base":    {"none"},
+			"unsafe":  {"false"},
+		},
+	})
 	if err != nil {
-		panic(err)
+		return "", err
+	}
+
+	response := struct {
+		Items []struct {
+			Filter string `json:"filter"`
+		} `json:"items"`
+	}{}
+	if err := json.Unmarshal(respBytes, &response); err != nil {
+		return "", err
+	}
+	if len(response.Items) == 0 || response.Items[0].Filter == "" {
+		return "", fmt.Errorf("no filter returned")
+	}
+	return response.Items[0].Filter, nil
+}
+
+func APIFilter() string {
+	if filter := GetConfig().APIFilter; filter != "" {
+		return filter
+	}
+
+	defaultFilterMu.Lock()
+	defer defaultFilterMu.Unlock()
+	if defaultFilter == "" {
+		filter, err := CreateFilter(filterFields)
+		if err != nil {
+			return fallbackFilter
+		}
+		defaultFilter = filter
+	}
+	return defaultFilter
+}
+
+var siteDomains = map[string]string{
+	"stackoverflow": "stackoverflow.com",
+	"superuser":     "superuser.com",
+	"serverfault":   "serverfault.com",
+	"askubuntu":     "askubuntu.com",
+	"stackapps":     "stackapps.com",
+	"mathoverflow":  "mathoverflow.net",
+}
+
+func SiteDomain(site string) string {
+	if domain, ok := siteDomains[site]; ok {
+		return domain
+	} else if strings.Contains(site, ".") {
+		return site
+	}
+	return site + ".stackexchange.com"
+}
+
+func Search(query Query, site string, sort string, order string, filter string) (SEResponse, error) {
+	if site == "" {
+		site = defaultSite
+	}
+	if order == "" {
+		order = defaultOrder
+	}
+	if filter == "" {
+		filter = APIFilter()
+	}
+
+	if query.IsAdvanced() {
+		if sort == "" {
+			sort = "relevance"
+		}
+
+		return MakeRequest(RequestOptions{
+			Path:   "search/advanced",
+			Params: query.Params(),
+			Sort:   sort,
+			Order:  order,
+			Site:   site,
+			Filter: filter,
+			Cache:  true,
+		})
+	}
+
+	searchResults, err := googlesearch.Search(nil, query.Text+" site:"+SiteDomain(site))
+	if err != nil {
+		return SEResponse{}, err
 	}
 
 	ids := ""
 	re := regexp.MustCompile("/questions/([0-9]+)/")
 
 	for _, result := range searchResults {
-		questionId := re.FindStringSubmatch(result.URL)[1]
+		match := re.FindStringSubmatch(result.URL)
+		if match == nil {
+			continue
+		}
+		questionId := match[1]
 
 		if ids == "" {
 			ids = questionId
@@ -25,17 +165,12 @@ func Search(query string, site string, sort string, order string, filter string)
 		}
 	}
 
-	if site == "" {
-		site = "stackoverflow"
+	if ids == "" {
+		return SEResponse{}, nil
 	}
+
 	if sort == "" {
-		sort = "votes"
-	}
-	if order == "" {
-		order = "desc"
-	}
-	if filter == "" {
-		filter = "!m()D0hHD1-.c61_vXxpH8BorZ9taft2)4vH6)J2QabmX)URKjC*VS(z2"
+		sort = defaultSort
 	}
 
 	return MakeRequest(RequestOptions{
@@ -46,3 +181,96 @@ func Search(query string, site string, sort string, order string, filter string)
 		Filter: filter,
 	})
 }
+
+func SearchAdvancedPage(query Query, site string, page int, pageSize int) (SEResponse, error) {
+	if site == "" {
+		site = defaultSite
+	}
+
+	return MakeRequest(RequestOptions{
+		Path:     "search/advanced",
+		Params:   query.Params(),
+		Sort:     "relevance",
+		Order:    defaultOrder,
+		Site:     site,
+		Filter:   APIFilter(),
+		Page:     page,
+		PageSize: pageSize,
+		Cache:    true,
+	})
+}
+
+func GetQuestion(id string, site string) (SEResponse, error) {
+	if site == "" {
+		site = defaultSite
+	}
+
+	return MakeRequest(RequestOptions{
+		IDs:    id,
+		Sort:   defaultSort,
+		Order:  defaultOrder,
+		Site:   site,
+		Filter: APIFilter(),
+	})
+}
+
+func GetAnswers(questionID int, site string) ([]Answer, error) {
+	if site == "" {
+		site = defaultSite
+	}
+
+	respBytes, err := FetchAPI(RequestOptions{
+		Path:     fmt.Sprintf("questions/%d/answers", questionID),
+		Sort:     defaultSort,
+		Order:    defaultOrder,
+		Site:     site,
+		Filter:   APIFilter(),
+		Page:     1,
+		PageSize: 100,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return DecodeAnswers(respBytes)
+}
+
+func GetQuestionComments(questionID int, site string) ([]Comment, error) {
+	if site == "" {
+		site = defaultSite
+	}
+
+	respBytes, err := FetchAPI(RequestOptions{
+		Path:     fmt.Sprintf("questions/%d/comments", questionID),
+		Sort:     "creation",
+		Order:    "asc",
+		Site:     site,
+		Filter:   APIFilter(),
+		Page:     1,
+		PageSize: 100,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return DecodeComments(respBytes)
+}
+
+func GetHotQuestions(site string) (SEResponse, error) {
+	return GetHotQuestionsPage(site, 0, 0)
+}
+
+func GetHotQuestionsPage(site string, page int, pageSize int) (SEResponse, error) {
+	if site == "" {
+		site = defaultSite
+	}
+
+	return MakeRequest(RequestOptions{
+		Sort:     "hot",
+		Order:    defaultOrder,
+		Site:     site,
+		Filter:   APIFilter(),
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
@@ -0,0 +1,298 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type Setting struct {
+	Name string
+	Get  func(c *Config) string
+	Set  func(c *Config, value string) error
+}
+
+func intSetting(name string, field func(c *Config) *int, min int, max int) Setting {
+	return Setting{
+		Name: name,
+		Get:  func(c *Config) string { return strconv.Itoa(*field(c)) },
+		Set: func(c *Config, value string) error {
+			n, err := strconv.Atoi(value)
+			if err != nil || n < min || n > max {
+				return fmt.Errorf("expected a number between %d and %d", min, max)
+			}
+			*field(c) = n
+			return nil
+		},
+	}
+}
+
+func boolSetting(name string, field func(c *Config) *bool) Setting {
+	return Setting{
+		Name: name,
+		Get:  func(c *Config) string { return strconv.FormatBool(*field(c)) },
+		Set: func(c *Config, value string) error {
+			b, err := strconv.ParseBool(value)
+			if err != nil {
+				return fmt.Errorf("expected true or false")
+			}
+			*field(c) = b
+			return nil
+		},
+	}
+}
+
+func choiceSetting(name string, field func(c *Config) *string, choices []string) Setting {
+	return Setting{
+		Name: name,
+		Get:  func(c *Config) string { return *field(c) },
+		Set: func(c *Config, value string) error {
+			for _, choice := range choices {
+				if choice == value {
+					*field(c) = value
+					return nil
+				}
+			}
+			return fmt.Errorf("expected one of %s", strings.Join(choices, ", "))
+		},
+	}
+}
+
+func stringSetting(name string, field func(c *Config) *string) Setting {
+	return Setting{
+		Name: name,
+		Get:  func(c *Config) string { return *field(c) },
+		Set: func(c *Config, value string) error {
+			*field(c) = value
+			return nil
+		},
+	}
+}
+
+func colorSetting(name string, field func(c *Config) *string) Setting {
+	return Setting{
+		Name: name,
+		Get:  func(c *Config) string { return *field(c) },
+		Set: func(c *Config, value string) error {
+			if value != "" && !colorPattern.MatchString(value) {
+				return fmt.Errorf("expected #rrggbb, an ANSI color number or nothing")
+			}
+			*field(c) = value
+			return nil
+		},
+	}
+}
+
+var borderNames = []string{"rounded", "normal", "thick", "double", "hidden", "none"}
+
+var settings = []Setting{
+	choiceSetting("theme", func(c *Config) *string { return &c.Theme }, markdownThemes),
+	stringSetting("default_site", func(c *Config) *string { return &c.DefaultSite }),
+	choiceSetting("density", func(c *Config) *string { return &c.Density }, densities),
+	choiceSetting("border", func(c *Config) *string { return &c.Border }, borderNames),
+	choiceSetting("log_position", func(c *Config) *string { return &c.LogPosition }, logPositions),
+	choiceSetting("enter_action", func(c *Config) *string { return &c.EnterAction }, enterActions),
+	choiceSetting("answer_sort", func(c *Config) *string { return &c.AnswerSort }, answerSorts),
+	intSetting("answer_limit", func(c *Config) *int { return &c.AnswerLimit }, 0, 1000),
+	intSetting("max_results", func(c *Config) *int { return &c.MaxResults }, 0, 100000),
+	intSetting("auto_refresh_minutes", func(c *Config) *int { return &c.AutoRefreshMinutes }, 0, 1440),
+	intSetting("cache_minutes", func(c *Config) *int { return &c.CacheMinutes }, 0, 10080),
+	intSetting("outdated_answer_years", func(c *Config) *int { return &c.OutdatedAnswerYears }, 0, 50),
+	intSetting("wrap_width", func(c *Config) *int { return &c.WrapWidth }, 0, maxWrapWidth),
+	intSetting("code_wrap_width", func(c *Config) *int { return &c.CodeWrapWidth }, 0, maxWrapWidth),
+	choiceSetting("submit_key", func(c *Config) *string { return &c.SubmitKey }, submitKeys),
+	stringSetting("hide_question_key", func(c *Config) *string { return &c.HideQuestionKey }),
+	stringSetting("browser", func(c *Config) *string { return &c.Browser }),
+	boolSetting("persist_errors", func(c *Config) *bool { return &c.PersistErrors }),
+	boolSetting("show_trending", func(c *Config) *bool { return &c.ShowTrending }),
+	boolSetting("filter_tags", func(c *Config) *bool { return &c.FilterTags }),
+	boolSetting("wrap_navigation", func(c *Config) *bool { return &c.WrapNavigation }),
+	boolSetting("confirm_destructive", func(c *Config) *bool { return &c.ConfirmDestructive }),
+	boolSetting("relax_empty_queries", func(c *Config) *bool { return &c.RelaxEmptyQueries }),
+	boolSetting("render_math", func(c *Config) *bool { return &c.RenderMath }),
+	boolSetting("show_key_hints", func(c *Config) *bool { return &c.ShowKeyHints }),
+	colorSetting("selection_color", func(c *Config) *string { return &c.SelectionColor }),
+}
+
+func (m *Model) ShowSettings() {
+	if m.state != EditingSettings {
+		m.settingsReturnState = m.state
+	}
+
+	snapshot := *GetConfig()
+	m.settingsSnapshot = &snapshot
+	m.settingsDirty = false
+	m.settingsError = ""
+
+	m.SetSettingsHeaders()
+	m.RefreshSettings()
+	m.settingsTable.GotoTop()
+	m.settingsTable.Focus()
+	m.textarea.Blur()
+	m.table.Blur()
+	m.state = EditingSettings
+}
+
+func (m *Model) SetSettingsHeaders() {
+	width := m.table.Width()
+
+	m.settingsTable.SetWidth(width)
+	m.settingsTable.SetHeight(m.table.Height() - 1)
+	m.settingsTable.SetColumns([]table.Column{
+		{Title: T("Setting"), Width: int(0.4 * float32(width))},
+		{Title: T("Value"), Width: int(0.6 * float32(width))},
+	})
+}
+
+func (m *Model) RefreshSettings() {
+	rows := []table.Row{}
+	for _, setting := range settings {
+		rows = append(rows, table.Row{setting.Name, fitCell(setting.Get(GetConfig()), int(0.6*float32(m.table.Width())))})
+	}
+	m.settingsTable.SetRows(rows)
+}
+
+func (m *Model) ApplySettings(previous Config) {
+	c := GetConfig()
+
+	m.theme = c.Theme
+	if c.DefaultSite != previous.DefaultSite {
+		m.site = c.DefaultSite
+	}
+	m.answerSort = c.AnswerSort
+	m.textarea.KeyMap.InsertNewline.SetEnabled(SubmitKey() != "enter")
+	if SubmitKey() != "enter" {
+		m.textarea.SetHeight(3)
+	} else {
+		m.textarea.SetHeight(1)
+	}
+	ApplySelectionColor(c.SelectionColor)
+	m.ApplyTableStyles()
+	ApplyBorderStyle(c.Border)
+	ApplyDensity(c.Density)
+	m.Resize(m.width, m.height)
+}
+
+func (m *Model) ApplyTableStyles() {
+	styles := TableStyles()
+	for _, t := range []*table.Model{&m.table, &m.linkTable, &m.summaryTable, &m.siteTable, &m.savedTable, &m.recentTable, &m.settingsTable} {
+		t.SetStyles(styles)
+	}
+}
+
+func (m *Model) HideSettings() {
+	m.settingsTable.Blur()
+	m.state = m.settingsReturnState
+
+	switch m.state {
+	case DisplayingQuestionAndAnswers:
+		m.RenderQuestion()
+	case DisplayingAllQuestions:
+		m.table.Focus()
+	default:
+		m.state = WaitingForInput
+		m.textarea.Focus()
+	}
+}
+
+func (m *Model) DiscardSettings() {
+	previous := *GetConfig()
+	*GetConfig() = *m.settingsSnapshot
+	m.ApplySettings(previous)
+	m.HideSettings()
+}
+
+func (m *Model) HandleSettingsKey(msg tea.KeyMsg) (tea.Cmd, bool) {
+	switch msg.String() {
+	case "enter":
+		cursor := m.settingsTable.Cursor()
+		if cursor < 0 || cursor >= len(settings) {
+			return nil, true
+		}
+
+		m.editingSetting = true
+		m.settingsError = ""
+		m.settingsInput.SetValue(settings[cursor].Get(GetConfig()))
+		m.settingsInput.CursorEnd()
+		m.settingsInput.Focus()
+		m.settingsTable.Blur()
+		return textinput.Blink, true
+	case "w":
+		if err := SaveConfig(); err != nil {
+			return getLogCmd(T("Unable to save config: %s", err), Error), true
+		}
+
+		m.settingsDirty = false
+		snapshot := *GetConfig()
+		m.settingsSnapshot = &snapshot
+		return getLogCmd(T("Saved settings"), Info), true
+	case "backspace", "esc":
+		if !m.settingsDirty {
+			m.HideSettings()
+			return nil, true
+		}
+
+		m.Confirm(T("Discard unsaved settings?"), func(m *Model) tea.Cmd {
+			m.DiscardSettings()
+			return getLogCmd(T("Discarded settings"), Info)
+		})
+		return nil, true
+	}
+	return nil, false
+}
+
+func (m Model) UpdateSettingInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyCtrlC:
+		return m, tea.Quit
+	case tea.KeyEsc:
+		m.StopEditingSetting()
+		return m, nil
+	case tea.KeyEnter:
+		setting := settings[m.settingsTable.Cursor()]
+		previous := *GetConfig()
+		if err := setting.Set(GetConfig(), strings.TrimSpace(m.settingsInput.Value())); err != nil {
+			m.settingsError = err.Error()
+			return m, nil
+		}
+
+		m.settingsDirty = true
+		m.StopEditingSetting()
+		m.ApplySettings(previous)
+		m.RefreshSettings()
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.settingsInput, cmd = m.settingsInput.Update(msg)
+	return m, cmd
+}
+
+func (m *Model) StopEditingSetting() {
+	m.editingSetting = false
+	m.settingsError = ""
+	m.settingsInput.Blur()
+	m.settingsTable.Focus()
+}
+
+func (m Model) SettingsView() string {
+	view := m.settingsTable.View() + "\n"
+
+	if m.editingSetting {
+		view += m.settingsInput.View()
+		if m.settingsError != "" {
+			view += " " + ErrorLogStyle.Copy().Padding(0, 1).Render(m.settingsError)
+		}
+		return view
+	}
+
+	hint := T("enter: edit · w: save to config · backspace: back")
+	if m.settingsDirty {
+		hint += T(" · unsaved changes")
+	}
+	return view + FadedStyle.Render(hint)
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sync"
+	"syscall"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type pendingWrite struct {
+	once  sync.Once
+	write func() error
+	err   error
+}
+
+var (
+	pendingWrites = map[*pendingWrite]bool{}
+	pendingLock   sync.Mutex
+	writeLock     sync.Mutex
+)
+
+func WriteFileAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+func (p *pendingWrite) Run() error {
+	p.once.Do(func() {
+		writeLock.Lock()
+		p.err = p.write()
+		writeLock.Unlock()
+
+		pendingLock.Lock()
+		delete(pendingWrites, p)
+		pendingLock.Unlock()
+	})
+	return p.err
+}
+
+func writeCmd(what string, write func() error) tea.Cmd {
+	p := &pendingWrite{write: write}
+	pendingLock.Lock()
+	pendingWrites[p] = true
+	pendingLock.Unlock()
+
+	return func() tea.Msg {
+		if err := p.Run(); err != nil {
+			return logMsg{Msg: T("Unable to update %s: %s", T(what), err), Type: Warning}
+		}
+		return nil
+	}
+}
+
+func FlushPendingWrites() []error {
+	pendingLock.Lock()
+	writes := []*pendingWrite{}
+	for p := range pendingWrites {
+		writes = append(writes, p)
+	}
+	pendingLock.Unlock()
+
+	errs := []error{}
+	for _, p := range writes {
+		if err := p.Run(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+// Bubble Tea already turns SIGINT and SIGTERM into a normal quit, so Run
+// returns and FlushPendingWrites gets to run. SIGHUP is not covered there.
+func HandleHangup() func() {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGHUP)
+
+	go func() {
+		if _, ok := <-signals; ok {
+			tui.Quit()
+		}
+	}()
+
+	return func() {
+		signal.Stop(signals)
+		close(signals)
+	}
+}
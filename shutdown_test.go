@@ -0,0 +1,23 @@
+package main
+
+import "testing"
+
+func TestFlushPendingWritesRunsEachWriteOnce(t *testing.T) {
+	calls := 0
+	cmd := writeCmd("test", func() error {
+		calls++
+		return nil
+	})
+
+	if errs := FlushPendingWrites(); len(errs) != 0 {
+		t.Fatalf("errs = %v", errs)
+	}
+	if msg := cmd(); msg != nil {
+		t.Errorf("msg = %v, want nil", msg)
+	}
+	FlushPendingWrites()
+
+	if calls != 1 {
+		t.Errorf("write ran %d times, want 1", calls)
+	}
+}
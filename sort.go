@@ -0,0 +1,56 @@
+package main
+
+import (
+	"sort"
+	"strings"
+)
+
+func (m *Model) SortByColumnAt(x int) {
+	offset := 0
+	for i := range tableColumns {
+		offset += m.ColumnWidth(i)
+		if x < offset {
+			if i == m.sortColumn {
+				m.sortDesc = !m.sortDesc
+			} else {
+				m.sortColumn = i
+				m.sortDesc = i != 1
+			}
+
+			m.SortResults()
+			return
+		}
+	}
+}
+
+func (m *Model) SortResults() {
+	if m.sortColumn < 0 {
+		return
+	}
+
+	items := m.response.Items
+	less := func(i, j int) bool {
+		a, b := items[i], items[j]
+		switch tableColumns[m.sortColumn].Title {
+		case "Title":
+			return strings.ToLower(a.DecodedTitle()) < strings.ToLower(b.DecodedTitle())
+		case "Score":
+			return a.Score < b.Score
+		case "Answers":
+			return a.AnswerCount < b.AnswerCount
+		case "Views":
+			return a.ViewCount < b.ViewCount
+		}
+		return a.QuestionID < b.QuestionID
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		if m.sortDesc {
+			return less(j, i)
+		}
+		return less(i, j)
+	})
+
+	m.SetTableHeaders()
+	m.ApplyFilter()
+}
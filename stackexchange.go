@@ -4,12 +4,18 @@ import (
 	"bytes"
 	"compress/gzip"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"html"
 	"io/ioutil"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/charmbracelet/bubbles/table"
+	"github.com/mattn/go-runewidth"
 )
 
 const (
@@ -25,31 +31,79 @@ var httpClient = &http.Client{
 	},
 }
 
+type Comment struct {
+	Owner     Owner  `json:"owner"`
+	Score     int    `json:"score"`
+	PostID    int    `json:"post_id"`
+	CommentID int    `json:"comment_id"`
+	Body      string `json:"body,omitempty"`
+}
+
+type Owner struct {
+	DisplayName string `json:"display_name"`
+}
+
+func (owner Owner) Name() string {
+	if owner.DisplayName == "" {
+		return "-"
+	}
+	return Sanitize(html.UnescapeString(owner.DisplayName))
+}
+
+type Answer struct {
+	Owner        Owner     `json:"owner"`
+	Comments     []Comment `json:"comments,omitempty"`
+	CommentCount int       `json:"comment_count"`
+	IsAccepted   bool      `json:"is_accepted"`
+	Score        int       `json:"score"`
+	CreationDate int       `json:"creation_date,omitempty"`
+	LastEditDate int       `json:"last_edit_date,omitempty"`
+	AnswerID     int       `json:"answer_id"`
+	QuestionID   int       `json:"question_id"`
+	BodyMarkdown string    `json:"body_markdown"`
+	Body         string    `json:"body,omitempty"`
+}
+
+func UserAgent() string {
+	if userAgent := GetConfig().UserAgent; userAgent != "" {
+		return userAgent
+	}
+	return fmt.Sprintf("sotui/%s (+https://github.com/Siris01/sotui)", version)
+}
+
 type ResponseItem struct {
-	Tags    []string `json:"tags"`
-	Answers []struct {
-		Comments []struct {
-			Score     int `json:"score"`
-			PostID    int `json:"post_id"`
-			CommentID int `json:"comment_id"`
-		} `json:"comments,omitempty"`
-		CommentCount int    `json:"comment_count"`
-		IsAccepted   bool   `json:"is_accepted"`
-		Score        int    `json:"score"`
-		LastEditDate int    `json:"last_edit_date,omitempty"`
-		AnswerID     int    `json:"answer_id"`
-		QuestionID   int    `json:"question_id"`
-		BodyMarkdown string `json:"body_markdown"`
-	} `json:"answers"`
-	ViewCount        int    `json:"view_count"`
-	AcceptedAnswerID int    `json:"accepted_answer_id,omitempty"`
-	AnswerCount      int    `json:"answer_count"`
-	Score            int    `json:"score"`
-	LastEditDate     int    `json:"last_edit_date,omitempty"`
-	QuestionID       int    `json:"question_id"`
-	BodyMarkdown     string `json:"body_markdown"`
-	Link             string `json:"link"`
-	Title            string `json:"title"`
+	Owner            Owner    `json:"owner"`
+	Tags             []string `json:"tags"`
+	Answers          []Answer `json:"answers"`
+	ViewCount        int      `json:"view_count"`
+	AcceptedAnswerID int      `json:"accepted_answer_id,omitempty"`
+	AnswerCount      int      `json:"answer_count"`
+	Score            int      `json:"score"`
+	CreationDate     int      `json:"creation_date,omitempty"`
+	LastEditDate     int      `json:"last_edit_date,omitempty"`
+	QuestionID       int      `json:"question_id"`
+	BodyMarkdown     string   `json:"body_markdown"`
+	Body             string   `json:"body,omitempty"`
+	Link             string   `json:"link"`
+	Title            string   `json:"title"`
+	BountyAmount     int      `json:"bounty_amount,omitempty"`
+	BountyClosesDate int      `json:"bounty_closes_date,omitempty"`
+	Site             string   `json:"-"`
+}
+
+func (item ResponseItem) DecodedTitle() string {
+	return Sanitize(html.UnescapeString(item.Title))
+}
+
+func (item ResponseItem) HasBounty() bool {
+	return item.BountyAmount > 0 && (item.BountyClosesDate == 0 || int64(item.BountyClosesDate) > time.Now().Unix())
+}
+
+func (item ResponseItem) BountyLabel() string {
+	if !item.HasBounty() {
+		return ""
+	}
+	return fmt.Sprintf("◆ +%d", item.BountyAmount)
 }
 
 type SEResponse struct {
@@ -57,59 +111,233 @@ type SEResponse struct {
 	HasMore        bool           `json:"has_more"`
 	QuotaMax       int            `json:"quota_max"`
 	QuotaRemaining int            `json:"quota_remaining"`
+	Site           string         `json:"-"`
+}
+
+var cellReplacer = strings.NewReplacer("\t", " ", "\n", " ", "\r", " ", "\u200d", "", "\ufe0f", "")
+
+func fitCell(value string, width int) string {
+	if width <= 0 {
+		return value
+	}
+
+	value = cellReplacer.Replace(value)
+	return runewidth.FillRight(runewidth.Truncate(value, width, "…"), width)
 }
 
-func (resp SEResponse) ToRows() []table.Row {
+func (resp SEResponse) ToRows(widths []int) []table.Row {
 	rows := []table.Row{}
 
 	for _, item := range resp.Items {
-		rows = append(rows, table.Row{
+		title := item.DecodedTitle()
+		if bounty := item.BountyLabel(); bounty != "" {
+			title = bounty + " " + title
+		}
+		if item.Site != "" {
+			title = "[" + item.Site + "] " + title
+		}
+
+		row := table.Row{
 			fmt.Sprintf("%d", item.QuestionID),
-			item.Title,
+			title,
 			fmt.Sprintf("%d", item.Score),
+			fmt.Sprintf("%d", item.AnswerCount),
 			fmt.Sprintf("%d", item.ViewCount),
-		})
+		}
+
+		for i := range row {
+			if i < len(widths) {
+				row[i] = fitCell(row[i], widths[i])
+			}
+		}
+
+		rows = append(rows, row)
 	}
 
 	return rows
 }
 
 type RequestOptions struct {
-	IDs    string
-	Sort   string
-	Order  string
-	Site   string
-	Filter string
+	Path     string
+	Params   url.Values
+	IDs      string
+	Sort     string
+	Order    string
+	Site     string
+	Filter   string
+	Page     int
+	PageSize int
+	Cache    bool
 }
 
 func (opts RequestOptions) GetURL() string {
-	return fmt.Sprintf("%s/%s/%s?site=%s&sort=%s&order=%s&filter=%s&access_token=%s&key=%s", baseApiURL, "questions", opts.IDs, opts.Site, opts.Sort, opts.Order, opts.Filter, GetToken(), authKey)
+	path := opts.Path
+	if path == "" {
+		path = "questions"
+		if opts.IDs != "" {
+			path += "/" + opts.IDs
+		}
+	}
+
+	query := url.Values{}
+	for key, values := range opts.Params {
+		query[key] = values
+	}
+	query.Set("site", opts.Site)
+	query.Set("sort", opts.Sort)
+	query.Set("order", opts.Order)
+	query.Set("filter", opts.Filter)
+	query.Set("access_token", GetToken())
+	query.Set("key", authKey)
+	if opts.Page > 0 {
+		query.Set("page", strconv.Itoa(opts.Page))
+		query.Set("pagesize", strconv.Itoa(opts.PageSize))
+	}
+
+	return baseApiURL + "/" + path + "?" + query.Encode()
+}
+
+type SEError struct {
+	ErrorID      int    `json:"error_id"`
+	ErrorName    string `json:"error_name"`
+	ErrorMessage string `json:"error_message"`
+}
+
+func PostAnswerVote(answerID int, site string, up bool) error {
+	action := "upvote"
+	if !up {
+		action = "downvote"
+	}
+
+	form := url.Values{}
+	form.Set("site", site)
+	form.Set("access_token", GetToken())
+	form.Set("key", authKey)
+	form.Set("preview", "false")
+
+	req, _ := http.NewRequest("POST", fmt.Sprintf("%s/answers/%d/%s", baseApiURL, answerID, action), strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", UserAgent())
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	respBytes, _ := ioutil.ReadAll(resp.Body)
+	if gzipReader, err := gzip.NewReader(bytes.NewReader(respBytes)); err == nil {
+		respBytes, _ = ioutil.ReadAll(gzipReader)
+	}
+
+	seErr := SEError{}
+	json.Unmarshal(respBytes, &seErr)
+	if seErr.ErrorID != 0 {
+		return fmt.Errorf("%s: %s", seErr.ErrorName, seErr.ErrorMessage)
+	}
+
+	return nil
+}
+
+func MakeRequest(opts RequestOptions) (SEResponse, error) {
+	respBytes, err := FetchAPI(opts)
+	if err != nil {
+		return SEResponse{}, err
+	}
+
+	return DecodeResponse(respBytes)
 }
 
-func MakeRequest(opts RequestOptions) SEResponse {
+func FetchAPI(opts RequestOptions) ([]byte, error) {
 	url := opts.GetURL()
+	if opts.Cache {
+		if cached, ok := ReadCachedResponse(url); ok {
+			RecordExchange(url, cached)
+			return cached, nil
+		}
+	}
+
 	req, _ := http.NewRequest("GET", url, nil)
 
 	req.Header.Set("Accept", "application/json")
-	req.Header.Set("User-Agent", "sotui")
+	req.Header.Set("User-Agent", UserAgent())
 	req.Header.Set("Connection", "keep-alive")
 
 	resp, err := httpClient.Do(req)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
 	defer resp.Body.Close()
 
-	respBytes, _ := ioutil.ReadAll(resp.Body)
-	gzipReader, _ := gzip.NewReader(bytes.NewReader(respBytes))
-	decompressedData, _ := ioutil.ReadAll(gzipReader)
+	respBytes, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if gzipReader, err := gzip.NewReader(bytes.NewReader(respBytes)); err == nil {
+		respBytes, _ = ioutil.ReadAll(gzipReader)
+	}
+	RecordExchange(url, respBytes)
+
+	seErr := SEError{}
+	json.Unmarshal(respBytes, &seErr)
+	if seErr.ErrorID != 0 {
+		return nil, fmt.Errorf("%s: %s", seErr.ErrorName, seErr.ErrorMessage)
+	}
+
+	if opts.Cache {
+		WriteCachedResponse(url, respBytes)
+	}
+	return respBytes, nil
+}
+
+func unmarshalLenient(data []byte, v interface{}) error {
+	err := json.Unmarshal(data, v)
+
+	var typeErr *json.UnmarshalTypeError
+	if err != nil && !errors.As(err, &typeErr) {
+		return err
+	}
+	return nil
+}
+
+func DecodeResponse(data []byte) (SEResponse, error) {
 	response := SEResponse{}
+	if err := unmarshalLenient(data, &response); err != nil {
+		return SEResponse{}, err
+	}
 
-	err = json.Unmarshal([]byte(string(decompressedData)), &response)
+	response.FillMarkdown()
+	response.Sanitize()
+	return response, nil
+}
 
-	if err != nil {
-		panic(err)
+func DecodeAnswers(data []byte) ([]Answer, error) {
+	response := struct {
+		Items []Answer `json:"items"`
+	}{}
+	if err := unmarshalLenient(data, &response); err != nil {
+		return nil, err
 	}
 
-	return response
+	wrapped := SEResponse{Items: []ResponseItem{{Answers: response.Items}}}
+	wrapped.FillMarkdown()
+	wrapped.Sanitize()
+	return wrapped.Items[0].Answers, nil
+}
+
+func DecodeComments(data []byte) ([]Comment, error) {
+	response := struct {
+		Items []Comment `json:"items"`
+	}{}
+	if err := unmarshalLenient(data, &response); err != nil {
+		return nil, err
+	}
+
+	for i := range response.Items {
+		comment := &response.Items[i]
+		comment.Body = Sanitize(HTMLToMarkdown(comment.Body))
+		comment.Owner.DisplayName = Sanitize(comment.Owner.DisplayName)
+	}
+	return response.Items, nil
 }
@@ -0,0 +1,151 @@
+package main
+
+import (
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mattn/go-runewidth"
+)
+
+func TestDecodeResponse(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr bool
+		check   func(t *testing.T, resp SEResponse)
+	}{
+		{
+			name: "unknown fields",
+			data: `{"items":[{"question_id":1,"title":"a","owner":{"reputation":5},"unexpected":[1,2]}],"new_field":true}`,
+			check: func(t *testing.T, resp SEResponse) {
+				if len(resp.Items) != 1 || resp.Items[0].QuestionID != 1 || resp.Items[0].Title != "a" {
+					t.Errorf("items = %+v", resp.Items)
+				}
+			},
+		},
+		{
+			name: "missing fields",
+			data: `{"items":[{"question_id":2}]}`,
+			check: func(t *testing.T, resp SEResponse) {
+				item := resp.Items[0]
+				if item.Title != "" || item.Answers != nil || item.Score != 0 || resp.HasMore {
+					t.Errorf("item = %+v", item)
+				}
+			},
+		},
+		{
+			name: "wrong typed field",
+			data: `{"items":[{"question_id":3,"score":"high","title":"b"}],"quota_remaining":10}`,
+			check: func(t *testing.T, resp SEResponse) {
+				if len(resp.Items) != 1 || resp.Items[0].QuestionID != 3 || resp.Items[0].Score != 0 {
+					t.Errorf("items = %+v", resp.Items)
+				}
+				if resp.QuotaRemaining != 10 {
+					t.Errorf("quota_remaining = %d, want 10", resp.QuotaRemaining)
+				}
+			},
+		},
+		{
+			name:    "malformed json",
+			data:    `{"items":[`,
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp, err := DecodeResponse([]byte(test.data))
+			if (err != nil) != test.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, test.wantErr)
+			}
+			if test.check != nil {
+				test.check(t, resp)
+			}
+		})
+	}
+}
+
+func TestDecodeCommentsToleratesTypeErrors(t *testing.T) {
+	comments, err := DecodeComments([]byte(`{"items":[{"comment_id":1,"score":"x","body":"<p>hi</p>"}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(comments) != 1 || comments[0].CommentID != 1 || comments[0].Body != "hi" {
+		t.Errorf("comments = %+v", comments)
+	}
+}
+
+func TestToRowsFitsCJKTitles(t *testing.T) {
+	title := "如何在Go语言中正确地处理并发写入映射时出现的数据竞争问题以及相关的最佳实践"
+
+	for _, width := range []int{61, 80, 101} {
+		m := newTestModel(DisplayingAllQuestions)
+		m.Resize(width, 40)
+		m.response = SEResponse{Items: []ResponseItem{{QuestionID: 1, Title: title}, {QuestionID: 2, Title: "短い"}}}
+		m.RefreshRows()
+
+		want := m.ColumnWidth(titleColumn)
+		for _, row := range m.table.Rows() {
+			if got := runewidth.StringWidth(row[titleColumn]); got != want {
+				t.Errorf("width %d: title %q is %d cells, want %d", width, row[titleColumn], got, want)
+			}
+		}
+	}
+}
+
+func TestGetURLEscapesParameters(t *testing.T) {
+	opts := RequestOptions{
+		Path:     "search/advanced",
+		Params:   url.Values{"q": {"a&b=c"}},
+		Sort:     "votes",
+		Order:    "desc",
+		Site:     "stack&overflow",
+		Filter:   "!a(b)*",
+		Page:     2,
+		PageSize: 30,
+	}
+
+	parsed, err := url.Parse(opts.GetURL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parsed.Path != "/2.3/search/advanced" {
+		t.Errorf("path = %q", parsed.Path)
+	}
+
+	query := parsed.Query()
+	want := map[string]string{"q": "a&b=c", "site": "stack&overflow", "sort": "votes", "order": "desc", "filter": "!a(b)*", "key": authKey, "page": "2", "pagesize": "30"}
+	for key, value := range want {
+		if got := query.Get(key); got != value {
+			t.Errorf("%s = %q, want %q", key, got, value)
+		}
+	}
+}
+
+func TestFilterFieldsCoverDecodedFields(t *testing.T) {
+	types := map[string]reflect.Type{
+		"":             reflect.TypeOf(SEResponse{}),
+		"question":     reflect.TypeOf(ResponseItem{}),
+		"answer":       reflect.TypeOf(Answer{}),
+		"comment":      reflect.TypeOf(Comment{}),
+		"shallow_user": reflect.TypeOf(Owner{}),
+	}
+
+	included := map[string]bool{}
+	for _, field := range filterFields {
+		included[field] = true
+	}
+	for prefix, typ := range types {
+		for i := 0; i < typ.NumField(); i++ {
+			name := strings.Split(typ.Field(i).Tag.Get("json"), ",")[0]
+			if name == "" || name == "-" {
+				continue
+			}
+			if field := prefix + "." + name; !included[field] {
+				t.Errorf("default filter is missing %s", field)
+			}
+		}
+	}
+}
@@ -0,0 +1,193 @@
+package main
+
+import (
+	"regexp"
+
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/lipgloss"
+)
+
+type Palette struct {
+	Text      lipgloss.Color
+	Accent    lipgloss.Color
+	Faded     lipgloss.Color
+	Positive  lipgloss.Color
+	Neutral   lipgloss.Color
+	Negative  lipgloss.Color
+	Header    lipgloss.Color
+	Selection lipgloss.Color
+	Glamour   string
+	Solid     bool
+}
+
+var palettes = map[string]Palette{
+	"dark": {
+		Text:      lipgloss.Color("#ffffff"),
+		Accent:    lipgloss.Color("#c6a0f6"),
+		Faded:     lipgloss.Color("#999999"),
+		Positive:  lipgloss.Color("#a6da95"),
+		Neutral:   lipgloss.Color("#eed49f"),
+		Negative:  lipgloss.Color("#ed8796"),
+		Header:    lipgloss.Color("#000000"),
+		Selection: lipgloss.Color("#494d64"),
+		Glamour:   "dark",
+	},
+	"light": {
+		Text:      lipgloss.Color("#000000"),
+		Accent:    lipgloss.Color("#8839ef"),
+		Faded:     lipgloss.Color("#6c6f85"),
+		Positive:  lipgloss.Color("#40a02b"),
+		Neutral:   lipgloss.Color("#df8e1d"),
+		Negative:  lipgloss.Color("#d20f39"),
+		Header:    lipgloss.Color("#ffffff"),
+		Selection: lipgloss.Color("#ccd0da"),
+		Glamour:   "light",
+	},
+	"high-contrast": {
+		Text:     lipgloss.Color("#ffffff"),
+		Accent:   lipgloss.Color("#ffff00"),
+		Faded:    lipgloss.Color("#ffffff"),
+		Positive: lipgloss.Color("#00ff00"),
+		Neutral:  lipgloss.Color("#ffff00"),
+		Negative: lipgloss.Color("#ff5555"),
+		Header:   lipgloss.Color("#000000"),
+		Glamour:  "dark",
+		Solid:    true,
+	},
+}
+
+var (
+	appearance = "dark"
+	palette    = palettes["dark"]
+
+	WhiteTextStyle     lipgloss.Style
+	BaseLogStyle       lipgloss.Style
+	InfoLogStyle       lipgloss.Style
+	WarningLogStyle    lipgloss.Style
+	ErrorLogStyle      lipgloss.Style
+	AccentStyle        lipgloss.Style
+	FadedStyle         lipgloss.Style
+	SelectedStyle      lipgloss.Style
+	BorderStyle        lipgloss.Style
+	PositiveScoreStyle lipgloss.Style
+	NeutralScoreStyle  lipgloss.Style
+	NegativeScoreStyle lipgloss.Style
+)
+
+func init() {
+	ApplyPalette("dark")
+}
+
+func DetectAppearance() string {
+	switch appearance := GetConfig().Appearance; appearance {
+	case "dark", "light", "high-contrast":
+		return appearance
+	}
+
+	if lipgloss.HasDarkBackground() {
+		return "dark"
+	}
+	return "light"
+}
+
+func ApplyPalette(name string) {
+	p, ok := palettes[name]
+	if !ok {
+		name, p = "dark", palettes["dark"]
+	}
+	appearance, palette = p.Glamour, p
+
+	alpha := "80"
+	WhiteTextStyle = lipgloss.NewStyle().Foreground(p.Text)
+	BaseLogStyle = WhiteTextStyle.Copy().AlignVertical(lipgloss.Center).AlignHorizontal(lipgloss.Center)
+	if p.Solid {
+		alpha = ""
+		BaseLogStyle = BaseLogStyle.Copy().Foreground(p.Header).Bold(true)
+	}
+	InfoLogStyle = BaseLogStyle.Copy().
+		Background(lipgloss.Color(string(p.Positive) + alpha))
+	WarningLogStyle = BaseLogStyle.Copy().
+		Background(lipgloss.Color(string(p.Neutral) + alpha))
+	ErrorLogStyle = BaseLogStyle.Copy().
+		Background(lipgloss.Color(string(p.Negative) + alpha))
+	AccentStyle = lipgloss.NewStyle().Foreground(p.Accent)
+	FadedStyle = lipgloss.NewStyle().Foreground(p.Faded)
+	if p.Solid {
+		FadedStyle = FadedStyle.Copy().Italic(true)
+	}
+	ApplySelectionColor("")
+	BorderStyle = lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).BorderForeground(p.Accent).Padding(1).Margin(1)
+
+	PositiveScoreStyle = lipgloss.NewStyle().Foreground(p.Positive).Bold(true)
+	NeutralScoreStyle = lipgloss.NewStyle().Foreground(p.Neutral).Bold(true)
+	NegativeScoreStyle = lipgloss.NewStyle().Foreground(p.Negative).Bold(true)
+}
+
+var colorPattern = regexp.MustCompile(`^(#[0-9a-fA-F]{6}|[0-9]{1,3})$`)
+
+func ApplySelectionColor(color string) bool {
+	SelectedStyle = AccentStyle.Copy().Background(palette.Selection).Bold(true)
+	if palette.Solid {
+		SelectedStyle = AccentStyle.Copy().Reverse(true).Bold(true)
+	}
+
+	if color == "" {
+		return true
+	} else if !colorPattern.MatchString(color) {
+		return false
+	}
+
+	SelectedStyle = lipgloss.NewStyle().Foreground(palette.Text).Background(lipgloss.Color(color)).Bold(true)
+	return true
+}
+
+func TableStyles() table.Styles {
+	return table.Styles{
+		Header:   lipgloss.NewStyle().Background(palette.Accent).Foreground(palette.Header),
+		Selected: SelectedStyle,
+	}
+}
+
+var borders = map[string]lipgloss.Border{
+	"rounded": lipgloss.RoundedBorder(),
+	"normal":  lipgloss.NormalBorder(),
+	"thick":   lipgloss.ThickBorder(),
+	"double":  lipgloss.DoubleBorder(),
+	"hidden":  lipgloss.HiddenBorder(),
+}
+
+func ApplyBorderStyle(name string) bool {
+	if name == "" {
+		name = "rounded"
+	}
+
+	if name == "none" {
+		BorderStyle = BorderStyle.Copy().UnsetBorderStyle()
+		return true
+	}
+
+	border, ok := borders[name]
+	if !ok {
+		border = lipgloss.RoundedBorder()
+	}
+
+	BorderStyle = BorderStyle.Copy().Border(border)
+	return ok
+}
+
+var densities = []string{"comfortable", "compact"}
+
+func ApplyDensity(name string) {
+	if name == "compact" {
+		BorderStyle = BorderStyle.Copy().Padding(0, 1).Margin(0)
+	} else {
+		BorderStyle = BorderStyle.Copy().Padding(1).Margin(1)
+	}
+}
+
+func NextDensity(name string) string {
+	if name == "compact" {
+		return densities[0]
+	}
+	return densities[1]
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func FirstLine(markdown string) string {
+	for _, line := range strings.Split(MarkdownToText(markdown), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			return line
+		}
+	}
+	return ""
+}
+
+func (m *Model) ShowAnswerSummary() tea.Cmd {
+	if len(m.renderedAnswers) == 0 {
+		return getLogCmd(T("This question has no answers"), Warning)
+	}
+
+	m.SetSummaryHeaders()
+	m.RefreshSummary()
+	_, index, _ := m.CurrentAnswer()
+	m.summaryTable.SetCursor(index)
+	m.summaryTable.Focus()
+	m.state = DisplayingAnswerSummary
+	return nil
+}
+
+func (m Model) SummaryColumnWidths() []int {
+	width := m.table.Width()
+	return []int{int(0.1 * float32(width)), int(0.2 * float32(width)), int(0.6 * float32(width)), int(0.1 * float32(width))}
+}
+
+func (m *Model) SetSummaryHeaders() {
+	widths := m.SummaryColumnWidths()
+
+	m.summaryTable.SetWidth(m.table.Width())
+	m.summaryTable.SetHeight(m.table.Height())
+	m.summaryTable.SetColumns([]table.Column{
+		{Title: T("Score"), Width: widths[0]},
+		{Title: T("Author"), Width: widths[1]},
+		{Title: T("Answer"), Width: widths[2]},
+		{Title: T("Accepted"), Width: widths[3]},
+	})
+}
+
+func (m *Model) RefreshSummary() {
+	widths := m.SummaryColumnWidths()
+
+	rows := []table.Row{}
+	for _, answer := range m.renderedAnswers {
+		accepted := ""
+		if answer.IsAccepted {
+			accepted = "✓"
+		}
+		rows = append(rows, table.Row{
+			fitCell(fmt.Sprintf("%d", answer.Score), widths[0]),
+			fitCell(answer.Owner.Name(), widths[1]),
+			fitCell(FirstLine(answer.BodyMarkdown), widths[2]),
+			fitCell(accepted, widths[3]),
+		})
+	}
+	m.summaryTable.SetRows(rows)
+}
+
+func (m *Model) HideAnswerSummary() {
+	m.summaryTable.Blur()
+	m.state = DisplayingQuestionAndAnswers
+}
+
+func (m *Model) HandleAnswerSummaryKey(msg tea.KeyMsg) (tea.Cmd, bool) {
+	switch msg.String() {
+	case "enter":
+		cursor := m.summaryTable.Cursor()
+		m.HideAnswerSummary()
+		if cursor >= 0 && cursor < len(m.answerOffsets) {
+			m.viewport.SetYOffset(m.answerOffsets[cursor])
+		}
+		return nil, true
+	case "backspace", "C":
+		m.HideAnswerSummary()
+		return nil, true
+	}
+	return nil, false
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type resumeMsg struct {
+	Err error
+}
+
+func (m Model) Suspend() tea.Cmd {
+	if !canSuspend {
+		return getLogCmd(T("Suspending is not supported on this platform"), Warning)
+	}
+
+	return func() tea.Msg {
+		if err := tui.ReleaseTerminal(); err != nil {
+			return resumeMsg{Err: err}
+		}
+
+		err := suspendProcess()
+		if restoreErr := tui.RestoreTerminal(); restoreErr != nil {
+			err = restoreErr
+		}
+		return resumeMsg{Err: err}
+	}
+}
+
+func (m *Model) Resume(msg resumeMsg) tea.Cmd {
+	if msg.Err != nil {
+		return getLogCmd(T("Unable to suspend: %s", msg.Err), Error)
+	}
+
+	m.Resize(m.width, m.height)
+	if m.state == DisplayingQuestionAndAnswers {
+		m.RenderQuestion()
+	}
+	return tea.Batch(tea.ClearScreen, m.mouse.Cmd())
+}
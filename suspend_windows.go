@@ -0,0 +1,9 @@
+//go:build windows
+
+package main
+
+const canSuspend = false
+
+func suspendProcess() error {
+	return nil
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	_ "embed"
+
+	"github.com/charmbracelet/glamour"
+)
+
+//go:embed themes/macchiato.json
+var macchiatoTheme []byte
+
+var markdownThemes = []string{"auto", "dark", "light", "dracula", "pink", "notty", "ascii", "macchiato"}
+
+func markdownThemeOption(theme string) glamour.TermRendererOption {
+	switch theme {
+	case "macchiato":
+		return glamour.WithStylesFromJSONBytes(macchiatoTheme)
+	case "dark", "light", "dracula", "pink", "notty", "ascii":
+		return glamour.WithStandardStyle(theme)
+	}
+	return glamour.WithStandardStyle(appearance)
+}
+
+func NextMarkdownTheme(theme string) string {
+	for i, name := range markdownThemes {
+		if name == theme {
+			return markdownThemes[(i+1)%len(markdownThemes)]
+		}
+	}
+	return markdownThemes[0]
+}
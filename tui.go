@@ -2,17 +2,19 @@ package main
 
 import (
 	"fmt"
+	"os"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/charmbracelet/bubbles/progress"
 	"github.com/charmbracelet/bubbles/spinner"
 	"github.com/charmbracelet/bubbles/table"
 	"github.com/charmbracelet/bubbles/textarea"
+	"github.com/charmbracelet/bubbles/textinput"
 	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
-	"github.com/charmbracelet/glamour"
-	"github.com/charmbracelet/lipgloss"
 )
 
 var tui *tea.Program
@@ -23,10 +25,15 @@ type Log struct {
 }
 
 type (
-	errMsg  error
-	State   int
-	LogType int
-	logMsg  Log
+	errMsg          error
+	State           int
+	LogType         int
+	logMsg          Log
+	questionMsg     SEResponse
+	clearLogMsg     int
+	voteMsg         Vote
+	connectivityMsg bool
+	MouseMode       int
 )
 
 const (
@@ -36,6 +43,16 @@ const (
 	DisplayingQuestionAndAnswers
 	DisplayingAllComments
 	DisplayingHelpScreen
+	DisplayingLinks
+	PickingSites
+	ComparingAnswers
+	DisplayingSavedAnswers
+	DisplayingRecentQuestions
+	EditingSettings
+	DisplayingRawResponse
+	DisplayingHelpfulAnswers
+	DisplayingAnswerSummary
+	DisplayingWideTable
 )
 
 const (
@@ -44,34 +61,187 @@ const (
 	Error
 )
 
+const (
+	MouseOff MouseMode = iota
+	MouseWheel
+	MouseFull
+)
+
+var mouseModeNames = []string{"off", "wheel", "full"}
+
+func (mode MouseMode) String() string {
+	return mouseModeNames[mode]
+}
+
+func ParseMouseMode(name string) MouseMode {
+	for i, modeName := range mouseModeNames {
+		if modeName == name {
+			return MouseMode(i)
+		}
+	}
+	return MouseFull
+}
+
+func (mode MouseMode) Cmd() tea.Cmd {
+	switch mode {
+	case MouseWheel:
+		return tea.EnableMouseCellMotion
+	case MouseFull:
+		return tea.EnableMouseAllMotion
+	}
+	return tea.DisableMouse
+}
+
 type Model struct {
 	table    table.Model
 	textarea textarea.Model
 	viewport viewport.Model
 	spinner  spinner.Model
-	mouse    bool
+	filter   textinput.Model
+	mouse    MouseMode
 	response SEResponse
+	question ResponseItem
 	state    State
 	err      error
+
+	statusMsg    string
+	page         int
+	lastPage     int
+	pageID       int
+	pages        map[int]SEResponse
+	waitStarted  time.Time
+	lastRefresh  time.Time
+	lastActivity time.Time
+	query        string
+	site         string
+	home         bool
+	theme        string
+	zoom         int
+
+	answerSort string
+
+	sortColumn int
+	titleRatio float32
+	sortDesc   bool
+
+	warnings []string
+
+	width  int
+	height int
+	log    *Log
+	logID  int
+
+	confirmation *Confirmation
+	undo         *Undo
+	undoID       int
+
+	scrollPositions map[int]int
+	scrollOrder     []int
+
+	lastFailed         *requestFailedMsg
+	checkingConnection bool
+
+	helpReturnState State
+
+	filtering bool
+	preview   bool
+
+	showComments     bool
+	questionComments map[string][]Comment
+	finding          bool
+	find             textinput.Model
+	findPattern      *regexp.Regexp
+	findMatches      []int
+	findIndex        int
+	filterInvalid    bool
+
+	linkTable       table.Model
+	summaryTable    table.Model
+	tableLines      []string
+	tableIndex      int
+	tableScroll     int
+	links           []Link
+	answerOffsets   []int
+	dividerOffset   int
+	renderedContent string
+	renderedAnswers []Answer
+	proseOnly       bool
+	lineNumbers     bool
+	plainText       bool
+	hideQuestion    bool
+	showAllTags     bool
+	highlightTerms  bool
+	showAllAnswers  bool
+	acceptedOnly    bool
+	scoreFilter     bool
+	minScore        int
+	markedAnswers   []int
+	comparePanes    []viewport.Model
+
+	siteTable   table.Model
+	pickedSites map[string]bool
+
+	bulk     *BulkFetch
+	bulkID   int
+	progress progress.Model
+
+	siteCounts    map[string]int
+	pendingSites  int
+	multiSiteID   int
+	multiSortCol  int
+	multiSortDesc bool
+
+	settingsTable       table.Model
+	settingsInput       textinput.Model
+	editingSetting      bool
+	settingsError       string
+	settingsDirty       bool
+	settingsSnapshot    *Config
+	settingsReturnState State
+
+	recentTable     table.Model
+	recentQuestions []RecentQuestion
+
+	savedTable      table.Model
+	savedAnswers    []SavedAnswer
+	answerMarks     map[int]AnswerMark
+	pendingAnswerID int
 }
 
-var (
-	WhiteTextStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#ffffff"))
-	BaseLogStyle   = WhiteTextStyle.Copy().AlignVertical(lipgloss.Center).AlignHorizontal(lipgloss.Center)
-	InfoLogStyle   = BaseLogStyle.Copy().
-			Background(lipgloss.Color("#a6da9580"))
-	WarningLogStyle = BaseLogStyle.Copy().
-			Background(lipgloss.Color("#eed49f80"))
-	ErrorLogStyle = BaseLogStyle.Copy().
-			Background(lipgloss.Color("#ed879680"))
-	AccentStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#c6a0f6"))
-	FadedStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("#999999"))
-	BorderStyle = lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).BorderForeground(lipgloss.Color("#c6a0f6")).Padding(1).Margin(1)
-)
+func initialModel(options CLIOptions) Model {
+	warnings := []string{}
+
+	if width := GetConfig().WrapWidth; width != 0 && !ValidWrapWidth(width) {
+		warnings = append(warnings, T("Ignoring wrap_width %d, expected %d-%d", width, minWrapWidth, maxWrapWidth))
+	}
+	if width := GetConfig().CodeWrapWidth; width != 0 && !ValidWrapWidth(width) {
+		warnings = append(warnings, T("Ignoring code_wrap_width %d, expected %d-%d", width, minWrapWidth, maxWrapWidth))
+	}
+	ApplyPalette(DetectAppearance())
+	if !ApplySelectionColor(GetConfig().SelectionColor) {
+		warnings = append(warnings, T("Ignoring selection_color %q, expected #rrggbb or an ANSI color number", GetConfig().SelectionColor))
+	}
+	if !ApplyBorderStyle(GetConfig().Border) {
+		warnings = append(warnings, T("Unknown border %q, using rounded", GetConfig().Border))
+	}
+	ApplyDensity(GetConfig().Density)
+	if GetConfig().Renderer == "glow" && GlowPath() == "" {
+		warnings = append(warnings, T("renderer is set to glow but glow is not on PATH, using glamour"))
+	}
+	if !ValidSubmitKey(GetConfig().SubmitKey) {
+		warnings = append(warnings, T("Unknown submit_key %q, expected one of %s", GetConfig().SubmitKey, strings.Join(submitKeys, ", ")))
+	}
+
+	marks, err := LoadAnswerMarks()
+	if err != nil {
+		warnings = append(warnings, T("Unable to read answer marks: %s", err))
+	}
 
-func initialModel() Model {
 	ta := textarea.New()
-	ta.Placeholder = "What is your question?"
+	ta.Placeholder = T("What is your question?")
+	if placeholder := GetConfig().Placeholder; placeholder != "" {
+		ta.Placeholder = placeholder
+	}
 	ta.Focus()
 
 	ta.Prompt = AccentStyle.Render("❯ ")
@@ -79,10 +249,13 @@ func initialModel() Model {
 
 	ta.SetWidth(30)
 	ta.SetHeight(1)
+	if SubmitKey() != "enter" {
+		ta.SetHeight(3)
+	}
 
 	ta.FocusedStyle.CursorLine = ta.FocusedStyle.CursorLine.Copy().UnsetBackground()
 	ta.ShowLineNumbers = false
-	ta.KeyMap.InsertNewline.SetEnabled(false)
+	ta.KeyMap.InsertNewline.SetEnabled(SubmitKey() != "enter")
 
 	vp := viewport.New(30, 3)
 	vp.MouseWheelEnabled = true
@@ -91,54 +264,212 @@ func initialModel() Model {
 	sp.Spinner = spinner.Dot
 	sp.Style = AccentStyle
 
+	fi := textinput.New()
+	fi.Prompt = AccentStyle.Render("/")
+	fi.Placeholder = T("regex filter, prefix with ~ to include bodies")
+
+	tableStyles := TableStyles()
+
 	tb := table.New()
 	tb.SetHeight(10)
 	tb.SetWidth(30)
-	tb.SetStyles(table.Styles{
-		Header:   lipgloss.NewStyle().Background(lipgloss.Color("#c6a0f6")).Foreground(lipgloss.Color("#000000")),
-		Selected: AccentStyle,
-	})
+	tb.SetStyles(tableStyles)
+
+	lt := table.New()
+	lt.SetStyles(tableStyles)
+
+	sm := table.New()
+	sm.SetStyles(tableStyles)
+
+	st := table.New()
+	st.SetStyles(tableStyles)
+
+	sa := table.New()
+	sa.SetStyles(tableStyles)
+
+	rt := table.New()
+	rt.SetStyles(tableStyles)
+
+	et := table.New()
+	et.SetStyles(tableStyles)
+
+	ei := textinput.New()
+	ei.Prompt = AccentStyle.Render("= ")
+
+	fd := textinput.New()
+	fd.Prompt = AccentStyle.Render(T("find: "))
+	fd.Placeholder = T("text to find in the question and all answers")
 
 	m := Model{
 		table:    tb,
 		textarea: ta,
 		viewport: vp,
 		spinner:  sp,
+		filter:   fi,
 		response: SEResponse{},
 		state:    WaitingForInput,
 		err:      nil,
-		mouse:    true,
+		mouse:    ParseMouseMode(GetConfig().Mouse),
+
+		site:         GetConfig().DefaultSite,
+		theme:        GetConfig().Theme,
+		answerSort:   GetConfig().AnswerSort,
+		sortColumn:   -1,
+		titleRatio:   GetConfig().TitleRatio,
+		warnings:     warnings,
+		linkTable:    lt,
+		summaryTable: sm,
+		siteTable:    st,
+		savedTable:   sa,
+		answerMarks:  marks,
+		recentTable:  rt,
+
+		progress: newProgress(),
+
+		settingsTable: et,
+		settingsInput: ei,
+		find:          fd,
+
+		scrollPositions:  map[int]int{},
+		questionComments: map[string][]Comment{},
+		highlightTerms:   true,
+	}
+
+	if options.Site != "" {
+		m.site = options.Site
+	}
+
+	if options.Query != "" {
+		m.query = options.Query
+		m.textarea.Blur()
+		m.StartWaiting(T("Searching %s...", m.site))
+	} else if !ConfigExists() {
+		m.ShowSitePicker()
+	} else if GetConfig().ShowTrending {
+		m.textarea.Blur()
+		m.StartWaiting(T("Loading hot questions..."))
+		m.home = true
 	}
 
 	m.SetTableHeaders()
 	return m
 }
 
+type TableColumn struct {
+	Title string
+	Ratio float32
+}
+
+var tableColumns = []TableColumn{
+	{Title: "ID", Ratio: 0.1},
+	{Title: "Title", Ratio: 0.6},
+	{Title: "Score", Ratio: 0.1},
+	{Title: "Answers", Ratio: 0.1},
+	{Title: "Views", Ratio: 0.1},
+}
+
 func (m *Model) SetTableHeaders() {
-	columns := []table.Column{
-		{
-			Title: "ID",
-			Width: int(0.1 * float32(m.table.Width())),
-		},
-		{
-			Title: "Title",
-			Width: int(0.7 * float32(m.table.Width())),
-		},
-		{
-			Title: "Score",
-			Width: int(0.1 * float32(m.table.Width())),
-		},
-		{
-			Title: "Views",
-			Width: int(0.2 * float32(m.table.Width())),
-		},
+	columns := []table.Column{}
+
+	for i, column := range tableColumns {
+		title := T(column.Title)
+		if i == m.sortColumn {
+			if m.sortDesc {
+				title += " ▼"
+			} else {
+				title += " ▲"
+			}
+		}
+
+		columns = append(columns, table.Column{
+			Title: title,
+			Width: m.ColumnWidth(i),
+		})
 	}
 
 	m.table.SetColumns(columns)
 }
 
+const (
+	titleColumn   = 1
+	minTitleRatio = 0.3
+	maxTitleRatio = 0.8
+	titleStep     = 0.05
+)
+
+func (m Model) ColumnRatio(i int) float32 {
+	if m.titleRatio < minTitleRatio || m.titleRatio > maxTitleRatio {
+		return tableColumns[i].Ratio
+	}
+
+	if i == titleColumn {
+		return m.titleRatio
+	}
+	return tableColumns[i].Ratio * (1 - m.titleRatio) / (1 - tableColumns[titleColumn].Ratio)
+}
+
+func (m Model) ColumnWidth(i int) int {
+	return int(m.ColumnRatio(i) * float32(m.table.Width()))
+}
+
+func (m *Model) ResizeTitleColumn(delta float32) tea.Cmd {
+	ratio := m.ColumnRatio(titleColumn) + delta
+	if ratio < minTitleRatio-0.001 || ratio > maxTitleRatio+0.001 {
+		return getLogCmd(T("Title column is already at its limit"), Warning)
+	}
+
+	m.titleRatio = ratio
+	m.SetTableHeaders()
+	m.RefreshRows()
+
+	GetConfig().TitleRatio = ratio
+	if err := SaveConfig(); err != nil {
+		return getLogCmd(T("Unable to save config: %s", err), Error)
+	}
+	return nil
+}
+
+func (m Model) ColumnWidths() []int {
+	widths := []int{}
+	for i := range tableColumns {
+		widths = append(widths, m.ColumnWidth(i))
+	}
+	return widths
+}
+
+func (m Model) SelectedItem() (ResponseItem, bool) {
+	if cursor := m.table.Cursor(); cursor < 0 || cursor >= len(m.table.Rows()) {
+		return ResponseItem{}, false
+	}
+
+	row := m.table.SelectedRow()
+	if len(row) == 0 {
+		return ResponseItem{}, false
+	}
+
+	selectedRowId, _ := strconv.Atoi(strings.TrimSpace(row[0]))
+
+	for _, item := range m.response.Items {
+		if item.QuestionID == selectedRowId {
+			return item, true
+		}
+	}
+	return ResponseItem{}, false
+}
+
 func (m Model) Init() tea.Cmd {
-	return textarea.Blink
+	cmds := []tea.Cmd{textarea.Blink, autoRefreshTick()}
+
+	if m.state == WaitingForResponse && m.home {
+		cmds = append(cmds, m.StartTrending())
+	} else if m.state == WaitingForResponse && m.query != "" {
+		cmds = append(cmds, m.StartSearch(m.query))
+	}
+
+	for _, warning := range m.warnings {
+		cmds = append(cmds, getLogCmd(warning, Warning))
+	}
+	return tea.Batch(cmds...)
 }
 
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
@@ -147,126 +478,277 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		taCmd tea.Cmd
 		vpCmd tea.Cmd
 		spCmd tea.Cmd
+		fiCmd tea.Cmd
+		ltCmd tea.Cmd
+		smCmd tea.Cmd
+		stCmd tea.Cmd
+		saCmd tea.Cmd
+		rtCmd tea.Cmd
+		etCmd tea.Cmd
 	)
 
+	if _, ok := msg.(tea.KeyMsg); ok {
+		m.CheckConnectivity()
+	}
+	switch msg.(type) {
+	case tea.KeyMsg, tea.MouseMsg:
+		m.lastActivity = time.Now()
+	}
+
+	if keyMsg, ok := msg.(tea.KeyMsg); ok && m.confirmation != nil {
+		return m.UpdateConfirmation(keyMsg)
+	}
+
+	if keyMsg, ok := msg.(tea.KeyMsg); ok && m.filtering {
+		return m.UpdateFilter(keyMsg)
+	}
+
+	if keyMsg, ok := msg.(tea.KeyMsg); ok && m.finding {
+		return m.UpdateFind(keyMsg)
+	}
+
+	if keyMsg, ok := msg.(tea.KeyMsg); ok && m.editingSetting {
+		return m.UpdateSettingInput(keyMsg)
+	}
+
+	if keyMsg, ok := msg.(tea.KeyMsg); ok && m.state == DisplayingAllQuestions && m.WrapTableCursor(keyMsg) {
+		return m, nil
+	}
+
+	if keyMsg, ok := msg.(tea.KeyMsg); ok && m.undo != nil && keyMsg.String() == "u" && m.state != WaitingForInput {
+		return m, m.RunUndo()
+	}
+
+	if mouseMsg, ok := msg.(tea.MouseMsg); ok && m.mouse == MouseWheel {
+		if mouseMsg.Type != tea.MouseWheelUp && mouseMsg.Type != tea.MouseWheelDown {
+			return m, nil
+		}
+	}
+
 	m.textarea, tiCmd = m.textarea.Update(msg)
 	m.table, taCmd = m.table.Update(msg)
 	m.viewport, vpCmd = m.viewport.Update(msg)
-	m.spinner, spCmd = m.spinner.Update(msg)
+	if _, ok := msg.(spinner.TickMsg); !ok || m.state == WaitingForResponse {
+		m.spinner, spCmd = m.spinner.Update(msg)
+	}
+	m.filter, fiCmd = m.filter.Update(msg)
+	m.linkTable, ltCmd = m.linkTable.Update(msg)
+	m.summaryTable, smCmd = m.summaryTable.Update(msg)
+	m.siteTable, stCmd = m.siteTable.Update(msg)
+	m.savedTable, saCmd = m.savedTable.Update(msg)
+	m.recentTable, rtCmd = m.recentTable.Update(msg)
+	m.settingsTable, etCmd = m.settingsTable.Update(msg)
 
 	switch msg := msg.(type) {
 	case tea.WindowSizeMsg:
-		m.table.SetHeight(msg.Height - 2)
-		m.table.SetWidth(msg.Width - 4)
-		m.SetTableHeaders()
+		m.Resize(msg.Width, msg.Height)
 
-		m.viewport.Height = msg.Height - 2
-		m.viewport.Width = msg.Width - 4
+		if m.state == DisplayingQuestionAndAnswers {
+			m.RenderQuestion()
+		} else if m.state == DisplayingWideTable {
+			m.RefreshWideTable()
+		}
 
-		m.textarea.SetWidth(msg.Width - 4)
+	case tea.MouseMsg:
+		if m.state == ComparingAnswers {
+			return m, m.UpdateComparison(msg)
+		}
+		if msg.Type == tea.MouseLeft && msg.Y == 0 && m.state == DisplayingAllQuestions {
+			m.SortByColumnAt(msg.X)
+			return m, nil
+		}
 
 	case tea.KeyMsg:
+		if cmd, ok := m.HandleKey(msg); ok {
+			return m, cmd
+		}
+
+		if m.state == WaitingForInput && msg.String() == SubmitKey() {
+			return m, tea.Batch(vpCmd, m.SubmitInput())
+		}
+
 		switch msg.Type {
 		case tea.KeyCtrlS:
-			if m.mouse {
-				m.mouse = false
-				return m, tea.Sequence(tea.DisableMouse, getLogCmd("Disabled mouse scroll/clicks", Info))
-			} else {
-				m.mouse = true
-				return m, tea.Sequence(tea.EnableMouseCellMotion, getLogCmd("Enabled mouse scroll/clicks", Info))
+			m.mouse = (m.mouse + 1) % MouseMode(len(mouseModeNames))
+
+			GetConfig().Mouse = m.mouse.String()
+			if err := SaveConfig(); err != nil {
+				return m, tea.Sequence(tea.DisableMouse, m.mouse.Cmd(), getLogCmd(T("Unable to save config: %s", err), Error))
 			}
+
+			return m, tea.Sequence(tea.DisableMouse, m.mouse.Cmd(), getLogCmd(T("Mouse mode: %s", m.mouse), Info))
 		case tea.KeyCtrlC, tea.KeyEsc:
 			return m, tea.Quit
+		case tea.KeyCtrlN:
+			return m, m.CycleSite()
+		case tea.KeyCtrlR:
+			return m, m.RetryLastRequest()
+		case tea.KeyCtrlZ:
+			return m, m.Suspend()
+		case tea.KeyCtrlX:
+			m.log = nil
+			return m, nil
 		case tea.KeyBackspace:
-			if m.state == DisplayingHelpScreen {
-				m.state = WaitingForInput //TODO: Track the previous state and go back to that ?
+			if m.state == DisplayingHelpScreen || m.state == DisplayingRawResponse {
+				m.HideHelp()
 				return m, nil
 			} else if m.state == DisplayingAllComments {
 				m.state = DisplayingQuestionAndAnswers
 				return m, nil
 			}
 			if m.state == DisplayingQuestionAndAnswers {
+				m.SaveScrollPosition()
 				m.state = DisplayingAllQuestions
 				m.table.Focus()
 				return m, nil
+			} else if m.state == DisplayingAllQuestions && m.filter.Value() != "" {
+				m.ClearFilter()
+				return m, nil
 			} else if m.state == DisplayingAllQuestions {
 				m.state = WaitingForInput
 				m.textarea.Focus()
 				return m, nil
 			}
 		case tea.KeyEnter:
-			if m.state == WaitingForInput {
-				go func() {
-					question := m.textarea.Value()
-					m.textarea.Reset()
-					resp := Search(question, "", "", "", "") //TODO: Add the other params here
-
-					tui.Send(resp)
-				}()
-				m.state = WaitingForResponse
-				return m, tea.Batch(vpCmd, spinner.Tick)
-			} else if m.state == DisplayingAllQuestions {
-				m.state = DisplayingQuestionAndAnswers
-				m.table.Blur()
-
-				selectedRowId, _ := strconv.Atoi(m.table.SelectedRow()[0])
-				row := func() ResponseItem {
-					for _, item := range m.response.Items {
-						if item.QuestionID == selectedRowId {
-							return item
-						}
-					}
-					return ResponseItem{}
-				}()
-
-				hr := lipgloss.NewStyle().Foreground(lipgloss.Color("#a6da95")).Render(strings.Repeat("-", m.viewport.Width))
-				question, _ := glamour.Render(fmt.Sprintf("# %s\n\n%s", row.Title, row.BodyMarkdown), "auto")
-				answers, _ := glamour.Render("\n\n\n\n# Answers:\n\n", "auto")
-
-				for _, answer := range row.Answers {
-					rendered, _ := glamour.Render(answer.BodyMarkdown, "auto")
-					answers += BorderStyle.Render(fmt.Sprintf("%s\n\n", rendered))
-				}
-
-				m.viewport.SetContent(question + hr + answers)
-				m.viewport.GotoTop()
-				return m, nil
+			if m.state == DisplayingAllQuestions {
+				return m, m.HandleEnter()
 			}
 		}
 
 	case SEResponse:
+		m.statusMsg = ""
 		if len(msg.Items) == 0 {
 			m.state = WaitingForInput
-			m.textarea.Blur()
+			m.textarea.Focus()
 			m.textarea.Reset()
-			return m, tea.Batch(tiCmd, taCmd, vpCmd, spCmd, getLogCmd("No results found", Warning))
+			return m, tea.Batch(tiCmd, taCmd, vpCmd, spCmd, getLogCmd(T("No results found"), Warning))
 		}
 
+		m.EndMultiSiteSearch()
+		m.lastRefresh = time.Now()
+		m.ResetPages(msg)
+		msg.Items = RankResults(TrimResults(msg.Items, GetConfig().MaxResults))
 		m.response = msg
+		if msg.Site != "" {
+			m.site = msg.Site
+		}
 		m.state = DisplayingAllQuestions
-		m.table.SetRows(m.response.ToRows())
+		m.filter.SetValue("")
+		m.RefreshRows()
+		m.SortResults()
 		m.textarea.Blur()
 		m.table.Focus()
 
+		return m, getLogCmd(m.ResultCountView(), Info)
+
+	case questionMsg:
+		m.statusMsg = ""
+		if len(msg.Items) == 0 {
+			m.state = WaitingForInput
+			m.textarea.Focus()
+			return m, getLogCmd(T("Question not found"), Error)
+		}
+
+		m.response = SEResponse(msg)
+		m.RefreshRows()
+		m.textarea.Blur()
+		m.textarea.Reset()
+		m.state = DisplayingQuestionAndAnswers
+		recordCmd := m.ShowQuestion(m.response.Items[0])
+		m.FocusPendingAnswer()
+
+		return m, tea.Batch(recordCmd, m.FetchMissingAnswers())
+
+	case autoRefreshTickMsg:
+		return m, m.AutoRefresh()
+
+	case autoRefreshMsg:
+		return m, m.ApplyAutoRefresh(msg)
+
+	case resumeMsg:
+		return m, m.Resume(msg)
+
+	case pageMsg:
+		return m, m.ApplyPage(msg)
+
+	case bulkPageMsg:
+		return m, m.ApplyBulkPage(msg)
+
+	case undoExpiredMsg:
+		m.ExpireUndo(int(msg))
+		if m.undo == nil {
+			m.HideEmptySavedAnswers()
+		}
 		return m, nil
 
+	case multiSiteMsg:
+		return m, m.MergeSiteResults(msg)
+
+	case pagerMsg:
+		os.Remove(msg.Path)
+		if msg.Err != nil {
+			return m, getLogCmd(T("Pager failed: %s", msg.Err), Error)
+		}
+		return m, nil
 	case logMsg:
 		if msg.Msg == "" {
-			//TODO:Remove the overlay component here
+			m.log = nil
 			return m, nil
 		}
-		switch msg.Type {
-		//TODO: Overlay component here in bottom right area
-		case Info:
-		case Warning:
-		case Error:
+
+		log := Log(msg)
+		m.log = &log
+		m.logID++
+
+		if msg.Type != Error || !GetConfig().PersistErrors {
+			id := m.logID
+			go func() {
+				time.Sleep(3 * time.Second)
+				tui.Send(clearLogMsg(id))
+			}()
+		}
+
+		return m, nil
+
+	case voteMsg:
+		m.statusMsg = ""
+		if msg.Err != nil {
+			return m, getLogCmd(T("Vote failed: %s", msg.Err), Error)
 		}
 
-		go func() {
-			time.Sleep(3 * time.Second)
-			tui.Send(getLogCmd("", Info))
-		}()
+		m.ApplyVote(Vote(msg))
+		return m, getLogCmd(T("Voted on answer %d", msg.AnswerID), Info)
+
+	case refreshMsg:
+		return m, m.ApplyRefresh(msg)
+
+	case answersMsg:
+		return m, m.ApplyAnswers(msg)
+
+	case questionCommentsMsg:
+		return m, m.ApplyQuestionComments(msg)
+
+	case requestFailedMsg:
+		m.statusMsg = ""
+		m.lastFailed = &msg
+		m.state = WaitingForInput
+		m.table.Blur()
+		m.textarea.Focus()
+		return m, getLogCmd(T("Request failed: %s (ctrl+r to retry)", msg.Err), Error)
 
+	case connectivityMsg:
+		m.checkingConnection = false
+		if bool(msg) && m.lastFailed != nil && m.confirmation == nil {
+			m.Confirm(T("Connection restored. Retry last search?"), func(m *Model) tea.Cmd {
+				return m.RetryLastRequest()
+			})
+		}
+		return m, nil
+
+	case clearLogMsg:
+		if int(msg) == m.logID {
+			m.log = nil
+		}
 		return m, nil
 
 	case errMsg:
@@ -275,30 +757,329 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	}
 
-	return m, tea.Batch(tiCmd, taCmd, vpCmd, spCmd)
+	return m, tea.Batch(tiCmd, taCmd, vpCmd, spCmd, fiCmd, ltCmd, smCmd, stCmd, saCmd, rtCmd, etCmd)
+}
+
+var submitKeys = []string{"enter", "alt+enter", "ctrl+j"}
+
+func ValidSubmitKey(key string) bool {
+	for _, submitKey := range submitKeys {
+		if submitKey == key {
+			return true
+		}
+	}
+	return false
+}
+
+func SubmitKey() string {
+	if key := GetConfig().SubmitKey; ValidSubmitKey(key) {
+		return key
+	}
+	return "enter"
+}
+
+func (m *Model) SubmitInput() tea.Cmd {
+	value := strings.TrimSpace(strings.ReplaceAll(m.textarea.Value(), "\n", " "))
+
+	if strings.HasPrefix(value, commandPrefix) {
+		return m.RunCommand(value)
+	} else if strings.HasPrefix(value, "#") {
+		id := strings.TrimSpace(strings.TrimPrefix(value, "#"))
+		if _, err := strconv.Atoi(id); err != nil {
+			return getLogCmd(T("Invalid question ID: %s", id), Error)
+		}
+
+		return m.StartQuestion(id)
+	}
+
+	m.textarea.Reset()
+	return m.StartSearch(value)
+}
+
+func (m *Model) Resize(width int, height int) {
+	m.width = width
+	m.height = height
+	if LogOnTop() {
+		height--
+	}
+
+	margin := 4
+	if GetConfig().Density == "compact" {
+		margin = 2
+	}
+
+	if m.preview {
+		m.table.SetHeight(height - 3 - previewHeight)
+	} else {
+		m.table.SetHeight(height - 3)
+	}
+	m.table.SetWidth(width - margin)
+	m.SetTableHeaders()
+	m.RefreshRows()
+	m.SetLinkHeaders()
+	m.SetSummaryHeaders()
+	m.RefreshSummary()
+	m.SetSitePickerHeaders()
+	m.SetSavedAnswerHeaders()
+	m.RefreshSavedAnswers()
+	m.SetRecentHeaders()
+	m.RefreshRecentQuestions()
+	m.SetSettingsHeaders()
+	m.RefreshSettings()
+
+	m.viewport.Height = height - 2
+	m.viewport.Width = width - margin
+
+	m.textarea.SetWidth(width - margin)
+}
+
+func (m *Model) ToggleDensity() tea.Cmd {
+	GetConfig().Density = NextDensity(GetConfig().Density)
+	ApplyDensity(GetConfig().Density)
+
+	m.Resize(m.width, m.height)
+	if m.state == DisplayingQuestionAndAnswers {
+		m.RenderQuestion()
+	}
+
+	if err := SaveConfig(); err != nil {
+		return getLogCmd(T("Unable to save config: %s", err), Error)
+	}
+	return getLogCmd(T("Density: %s", GetConfig().Density), Info)
+}
+
+func (m *Model) StartQuestion(id string) tea.Cmd {
+	request := Request{Kind: QuestionRequest, Query: id, Site: m.site}
+
+	go func() {
+		resp, err := GetQuestion(id, request.Site)
+		if err != nil {
+			tui.Send(requestFailedMsg{Request: request, Err: err})
+			return
+		}
+
+		tui.Send(questionMsg(resp))
+	}()
+	m.StartWaiting(T("Loading question #%s...", id))
+	return spinner.Tick
+}
+
+func (m *Model) StartTrending() tea.Cmd {
+	m.query = ""
+	m.home = true
+	request := Request{Kind: TrendingRequest, Site: m.site}
+
+	go func() {
+		resp, err := GetHotQuestions(request.Site)
+		if err != nil {
+			tui.Send(requestFailedMsg{Request: request, Err: err})
+			return
+		}
+
+		tui.Send(resp)
+	}()
+	m.StartWaiting(T("Loading hot questions..."))
+	return spinner.Tick
+}
+
+func (m *Model) RefineSearch() tea.Cmd {
+	m.SaveScrollPosition()
+	m.textarea.SetValue(m.question.DecodedTitle())
+	m.textarea.CursorEnd()
+	m.state = WaitingForInput
+	m.textarea.Focus()
+	return getLogCmd(T("Edit the title and press %s to search again", SubmitKey()), Info)
+}
+
+func (m *Model) StartSearch(query string) tea.Cmd {
+	m.query = query
+	m.home = false
+	request := Request{Kind: SearchRequest, Query: query, Site: m.site}
+
+	var logCmd tea.Cmd
+	parsed, err := ParseQuery(query)
+	if err != nil {
+		logCmd = getLogCmd(T("Unable to parse query (%s), searching as plain text", err), Warning)
+	} else {
+		site := m.site
+		if site == "" {
+			site = defaultSite
+		}
+		logCmd = getLogCmd(T("Searching %s for %q", site, query), Info)
+	}
+
+	go func() {
+		resp, err := Search(parsed, request.Site, "", "", "")
+		if err != nil {
+			tui.Send(requestFailedMsg{Request: request, Err: err})
+			return
+		}
+		resp.Site = request.Site
+
+		for _, fallback := range GetConfig().SearchFallbacks {
+			if len(resp.Items) > 0 {
+				break
+			}
+
+			tui.Send(logMsg{Msg: T("No results, trying %s sorted by %s", fallback.Site, fallback.Sort), Type: Info})
+			resp, err = Search(parsed, fallback.Site, fallback.Sort, "", "")
+			if err != nil {
+				tui.Send(requestFailedMsg{Request: request, Err: err})
+				return
+			}
+			resp.Site = fallback.Site
+		}
+
+		if relaxed, how, ok := parsed.Relax(); ok && len(resp.Items) == 0 && GetConfig().RelaxEmptyQueries {
+			tui.Send(logMsg{Msg: T("No results, retrying by %s", T(how)), Type: Info})
+			resp, err = Search(relaxed, request.Site, "", "", "")
+			if err != nil {
+				tui.Send(requestFailedMsg{Request: request, Err: err})
+				return
+			}
+			resp.Site = request.Site
+		}
+
+		tui.Send(resp)
+	}()
+	searching := T("Searching {site}...")
+	if text := GetConfig().SearchingText; text != "" {
+		searching = text
+	}
+	m.StartWaiting(strings.ReplaceAll(searching, "{site}", m.site))
+	return tea.Batch(logCmd, spinner.Tick)
+}
+
+func (m *Model) CycleSite() tea.Cmd {
+	sites := GetConfig().FavoriteSites
+	if len(sites) == 0 {
+		return getLogCmd(T("No favorite sites configured"), Warning)
+	}
+
+	next := sites[0]
+	for i, site := range sites {
+		if site == m.site {
+			next = sites[(i+1)%len(sites)]
+			break
+		}
+	}
+	m.site = next
+
+	logCmd := getLogCmd(T("Switched to %s", m.site), Info)
+	if m.query == "" || m.state == WaitingForInput || m.state == WaitingForResponse {
+		return logCmd
+	}
+
+	m.table.Blur()
+	return tea.Batch(logCmd, m.StartSearch(m.query))
+}
+
+func (m *Model) StartWaiting(status string) {
+	m.state = WaitingForResponse
+	m.statusMsg = status
+	m.waitStarted = time.Now()
+}
+
+func (m Model) ResultCountView() string {
+	count := len(m.response.Items)
+	switch {
+	case m.home:
+		return T("Loaded %d hot questions", count)
+	case count == 1:
+		return T("Found 1 result")
+	}
+	return T("Found %d results", count)
+}
+
+func (m Model) ElapsedView() string {
+	elapsed := time.Since(m.waitStarted)
+	if elapsed < time.Second {
+		return ""
+	}
+	return FadedStyle.Render(fmt.Sprintf(" %.1fs", elapsed.Seconds()))
+}
+
+func (m Model) InputCounterView() string {
+	value := m.textarea.Value()
+	chars := len([]rune(value))
+	counter := T("%d/%d chars · %d words", chars, m.textarea.CharLimit, len(strings.Fields(value)))
+
+	if chars >= m.textarea.CharLimit*9/10 {
+		return ErrorLogStyle.Copy().Padding(0, 1).Render(counter)
+	}
+	return FadedStyle.Copy().PaddingLeft(2).Render(counter)
 }
 
 func (m Model) View() string {
+	return m.HeaderView() + m.MainView() + "\n" + m.FooterView()
+}
+
+func (m Model) MainView() string {
 	if m.err != nil {
 		return m.err.Error()
 	} else if m.state == WaitingForInput {
-		return m.textarea.View()
+		return m.textarea.View() + "\n" + m.InputCounterView()
 	} else if m.state == WaitingForResponse {
-		return m.spinner.View() + " Searching..."
+		return m.spinner.View() + " " + m.statusMsg + m.ElapsedView()
+	} else if m.state == DisplayingAllQuestions && m.bulk != nil {
+		return m.table.View() + "\n" + m.BulkProgressView()
+	} else if m.state == DisplayingAllQuestions && (m.filtering || m.filter.Value() != "") {
+		return m.table.View() + "\n" + m.filter.View()
+	} else if m.state == DisplayingAllQuestions && m.preview {
+		return m.table.View() + "\n" + m.PreviewView()
 	} else if m.state == DisplayingAllQuestions {
 		return m.table.View()
-	} else if m.state == DisplayingQuestionAndAnswers || m.state == DisplayingAllComments || m.state == DisplayingHelpScreen {
+	} else if m.state == DisplayingLinks {
+		return m.linkTable.View()
+	} else if m.state == DisplayingAnswerSummary {
+		return m.summaryTable.View()
+	} else if m.state == ComparingAnswers {
+		return m.ComparisonView()
+	} else if m.state == DisplayingSavedAnswers || m.state == DisplayingHelpfulAnswers {
+		return m.savedTable.View()
+	} else if m.state == EditingSettings {
+		return m.SettingsView()
+	} else if m.state == DisplayingRecentQuestions {
+		return m.recentTable.View()
+	} else if m.state == PickingSites {
+		return m.siteTable.View() + "\n" + sitePickerHint()
+	} else if m.state == DisplayingQuestionAndAnswers {
+		return m.QuestionView()
+	} else if m.state == DisplayingAllComments || m.state == DisplayingHelpScreen || m.state == DisplayingRawResponse || m.state == DisplayingWideTable {
 		return m.viewport.View()
 	}
 
 	return ""
 }
 
-func RunTUI() {
-	var m = initialModel()
-	tui = tea.NewProgram(m, tea.WithMouseCellMotion())
+func RunTUI(options CLIOptions) {
+	if !IsTerminal() {
+		if err := PrintResults(os.Stdout, options.Query, options.Site); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	var m = initialModel(options)
+	opts := []tea.ProgramOption{}
 
-	if _, err := tui.Run(); err != nil {
+	switch m.mouse {
+	case MouseWheel:
+		opts = append(opts, tea.WithMouseCellMotion())
+	case MouseFull:
+		opts = append(opts, tea.WithMouseAllMotion())
+	}
+
+	tui = tea.NewProgram(m, opts...)
+	stop := HandleHangup()
+
+	_, err := tui.Run()
+	stop()
+	for _, err := range FlushPendingWrites() {
+		fmt.Fprintln(os.Stderr, T("Unable to save state: %s", err))
+	}
+	if err != nil {
 		panic(err)
 	}
 }
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestMain(m *testing.M) {
+	home, err := os.MkdirTemp("", "sotui-test")
+	if err != nil {
+		panic(err)
+	}
+	os.Setenv("HOME", home)
+
+	code := m.Run()
+	os.RemoveAll(home)
+	os.Exit(code)
+}
+
+func newTestModel(state State) Model {
+	m := initialModel(CLIOptions{})
+	m.Resize(100, 40)
+	m.state = state
+	return m
+}
+
+func TestEnterOnEmptyTable(t *testing.T) {
+	m := newTestModel(DisplayingAllQuestions)
+	m.textarea.Blur()
+	m.table.Focus()
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if state := updated.(Model).state; state != DisplayingAllQuestions {
+		t.Errorf("state = %v, want %v", state, DisplayingAllQuestions)
+	}
+}
+
+func collectMsgs(cmd tea.Cmd) []tea.Msg {
+	if cmd == nil {
+		return nil
+	}
+
+	done := make(chan tea.Msg, 1)
+	go func() { done <- cmd() }()
+
+	select {
+	case msg := <-done:
+		if batch, ok := msg.(tea.BatchMsg); ok {
+			msgs := []tea.Msg{}
+			for _, cmd := range batch {
+				msgs = append(msgs, collectMsgs(cmd)...)
+			}
+			return msgs
+		}
+		return []tea.Msg{msg}
+	case <-time.After(time.Second):
+		return nil
+	}
+}
+
+func hasSpinnerTick(cmd tea.Cmd) bool {
+	for _, msg := range collectMsgs(cmd) {
+		if _, ok := msg.(spinner.TickMsg); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func TestSpinnerTicksOnlyWhileWaiting(t *testing.T) {
+	m := newTestModel(DisplayingAllQuestions)
+	if _, cmd := m.Update(m.spinner.Tick()); hasSpinnerTick(cmd) {
+		t.Error("spinner kept ticking while displaying questions")
+	}
+
+	m = newTestModel(WaitingForResponse)
+	if _, cmd := m.Update(m.spinner.Tick()); !hasSpinnerTick(cmd) {
+		t.Error("spinner stopped ticking while waiting for a response")
+	}
+}
+
+func TestTableHeadersAndCounterAreTranslated(t *testing.T) {
+	GetConfig().Locale = "xx"
+	localesLock.Lock()
+	locales["xx"] = map[string]string{
+		"Title":                  "Titre",
+		"%d/%d chars · %d words": "%d/%d car. · %d mots",
+	}
+	localesLock.Unlock()
+	t.Cleanup(func() {
+		GetConfig().Locale = ""
+		localesLock.Lock()
+		delete(locales, "xx")
+		localesLock.Unlock()
+	})
+
+	m := newTestModel(DisplayingAllQuestions)
+	m.SetTableHeaders()
+	if view := m.table.View(); !strings.Contains(view, "Titre") {
+		t.Errorf("table headers not translated:\n%s", view)
+	}
+	if counter := m.InputCounterView(); !strings.Contains(counter, "car.") {
+		t.Errorf("counter not translated: %q", counter)
+	}
+}
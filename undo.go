@@ -0,0 +1,50 @@
+package main
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const undoTimeout = 5 * time.Second
+
+type Undo struct {
+	ID      int
+	Restore func(m *Model) tea.Cmd
+}
+
+type undoExpiredMsg int
+
+func (m *Model) ConfirmDestructive(prompt string, action func(m *Model) tea.Cmd) tea.Cmd {
+	if !GetConfig().ConfirmDestructive {
+		return action(m)
+	}
+
+	m.Confirm(prompt, action)
+	return nil
+}
+
+func (m *Model) OfferUndo(description string, restore func(m *Model) tea.Cmd) tea.Cmd {
+	m.undoID++
+	id := m.undoID
+	m.undo = &Undo{ID: id, Restore: restore}
+
+	return tea.Batch(
+		getLogCmd(T("%s — press u to undo", description), Info),
+		tea.Tick(undoTimeout, func(time.Time) tea.Msg {
+			return undoExpiredMsg(id)
+		}),
+	)
+}
+
+func (m *Model) RunUndo() tea.Cmd {
+	undo := m.undo
+	m.undo = nil
+	return undo.Restore(m)
+}
+
+func (m *Model) ExpireUndo(id int) {
+	if m.undo != nil && m.undo.ID == id {
+		m.undo = nil
+	}
+}
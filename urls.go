@@ -0,0 +1,35 @@
+package main
+
+import "fmt"
+
+func siteURL(site string) string {
+	if site == "" {
+		site = defaultSite
+	}
+	return "https://" + SiteDomain(site)
+}
+
+func QuestionURL(site string, questionID int) string {
+	return fmt.Sprintf("%s/questions/%d", siteURL(site), questionID)
+}
+
+func AnswerURL(site string, questionID int, answerID int) string {
+	return fmt.Sprintf("%s/%d#%d", QuestionURL(site, questionID), answerID, answerID)
+}
+
+func CommentURL(site string, postID int, commentID int) string {
+	return fmt.Sprintf("%s/posts/comments/%d?postid=%d", siteURL(site), commentID, postID)
+}
+
+func (item ResponseItem) URL(site string) string {
+	if item.Site != "" {
+		site = item.Site
+	}
+	if item.Link != "" {
+		return item.Link
+	}
+	if item.QuestionID == 0 {
+		return ""
+	}
+	return QuestionURL(site, item.QuestionID)
+}
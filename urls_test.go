@@ -0,0 +1,28 @@
+package main
+
+import "testing"
+
+func TestURLs(t *testing.T) {
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{"default question", QuestionURL("", 11), "https://stackoverflow.com/questions/11"},
+		{"default answer", AnswerURL("", 11, 12), "https://stackoverflow.com/questions/11/12#12"},
+		{"default comment", CommentURL("", 11, 13), "https://stackoverflow.com/posts/comments/13?postid=11"},
+		{"superuser question", QuestionURL("superuser", 21), "https://superuser.com/questions/21"},
+		{"superuser answer", AnswerURL("superuser", 21, 22), "https://superuser.com/questions/21/22#22"},
+		{"superuser comment", CommentURL("superuser", 21, 23), "https://superuser.com/posts/comments/23?postid=21"},
+		{"stackexchange subdomain", QuestionURL("unix", 31), "https://unix.stackexchange.com/questions/31"},
+		{"item site", ResponseItem{QuestionID: 41, Site: "superuser"}.URL("stackoverflow"), "https://superuser.com/questions/41"},
+		{"item link over site", ResponseItem{QuestionID: 42, Site: "superuser", Link: "https://superuser.com/questions/42/title"}.URL("stackoverflow"), "https://superuser.com/questions/42/title"},
+		{"no question", ResponseItem{}.URL("superuser"), ""},
+	}
+
+	for _, test := range tests {
+		if test.got != test.want {
+			t.Errorf("%s: got %q, want %q", test.name, test.got, test.want)
+		}
+	}
+}
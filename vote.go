@@ -0,0 +1,67 @@
+package main
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type Vote struct {
+	AnswerID int
+	Up       bool
+	Err      error
+}
+
+func Login(scope string) tea.Cmd {
+	go Oauth2(scope)
+	return openURLCmd(GetAuthURL(scope))
+}
+
+func (m *Model) ConfirmVote(up bool) tea.Cmd {
+	answer, index, ok := m.CurrentAnswer()
+	if !ok {
+		return getLogCmd(T("No answer in view"), Warning)
+	}
+
+	if GetToken() == "" || !HasWriteAccess() {
+		m.Confirm(T("Voting needs write access to your account. Sign in to grant it?"), func(m *Model) tea.Cmd {
+			return Login(writeScope)
+		})
+		return nil
+	}
+
+	prompt := "Upvote answer %d?"
+	if !up {
+		prompt = "Downvote answer %d?"
+	}
+
+	site := m.QuestionSite()
+	if site == "" {
+		site = defaultSite
+	}
+
+	m.Confirm(T(prompt, index+1), func(m *Model) tea.Cmd {
+		go func() {
+			err := PostAnswerVote(answer.AnswerID, site, up)
+
+			tui.Send(voteMsg(Vote{AnswerID: answer.AnswerID, Up: up, Err: err}))
+		}()
+		return getLogCmd(T("Sending vote..."), Info)
+	})
+	return nil
+}
+
+func (m *Model) ApplyVote(vote Vote) {
+	delta := 1
+	if !vote.Up {
+		delta = -1
+	}
+
+	for i, answer := range m.question.Answers {
+		if answer.AnswerID == vote.AnswerID {
+			m.question.Answers[i].Score += delta
+		}
+	}
+
+	if m.state == DisplayingQuestionAndAnswers {
+		m.RenderQuestion()
+	}
+}
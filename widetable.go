@@ -0,0 +1,116 @@
+package main
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	wideTableWidth  = 1000
+	wideTableScroll = 8
+)
+
+func RenderWideTable(table string) []string {
+	lines := strings.Split(strings.Trim(RenderMarkdown(table, "notty", wideTableWidth), "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " ")
+	}
+	return lines
+}
+
+func TableWidth(lines []string) int {
+	width := 0
+	for _, line := range lines {
+		if w := lipgloss.Width(line); w > width {
+			width = w
+		}
+	}
+	return width
+}
+
+func HasWideTable(markdown string, width int) bool {
+	for _, table := range ExtractTables(markdown) {
+		if TableWidth(RenderWideTable(table)) > width {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *Model) ShowWideTable() tea.Cmd {
+	answer, _, ok := m.CurrentAnswer()
+	if !ok {
+		return getLogCmd(T("No answer in view"), Warning)
+	}
+
+	tables := ExtractTables(answer.BodyMarkdown)
+	if len(tables) == 0 {
+		return getLogCmd(T("No tables in this answer"), Warning)
+	}
+
+	if m.state == DisplayingWideTable {
+		m.tableIndex = (m.tableIndex + 1) % len(tables)
+	} else {
+		m.SaveScrollPosition()
+		m.tableIndex = 0
+	}
+	m.tableLines = RenderWideTable(tables[m.tableIndex])
+	m.tableScroll = 0
+	m.state = DisplayingWideTable
+	m.RefreshWideTable()
+	m.viewport.GotoTop()
+	return getLogCmd(T("Table %d of %d, scroll with left/right", m.tableIndex+1, len(tables)), Info)
+}
+
+func (m *Model) ScrollWideTable(delta int) {
+	m.tableScroll += delta
+	if max := TableWidth(m.tableLines) - m.viewport.Width; m.tableScroll > max {
+		m.tableScroll = max
+	}
+	if m.tableScroll < 0 {
+		m.tableScroll = 0
+	}
+	m.RefreshWideTable()
+}
+
+func (m *Model) RefreshWideTable() {
+	visible := []string{}
+	for _, line := range m.tableLines {
+		runes := []rune(line)
+		if m.tableScroll >= len(runes) {
+			visible = append(visible, "")
+			continue
+		}
+		runes = runes[m.tableScroll:]
+		if len(runes) > m.viewport.Width {
+			runes = runes[:m.viewport.Width]
+		}
+		visible = append(visible, string(runes))
+	}
+	m.viewport.SetContent(strings.Join(visible, "\n"))
+}
+
+func (m *Model) HideWideTable() {
+	m.state = DisplayingQuestionAndAnswers
+	m.RenderQuestion()
+	m.RestoreScrollPosition()
+}
+
+func (m *Model) HandleWideTableKey(msg tea.KeyMsg) (tea.Cmd, bool) {
+	switch msg.String() {
+	case "left", "h":
+		m.ScrollWideTable(-wideTableScroll)
+		return nil, true
+	case "right", "l":
+		m.ScrollWideTable(wideTableScroll)
+		return nil, true
+	case "W":
+		return m.ShowWideTable(), true
+	case "backspace":
+		m.HideWideTable()
+		return nil, true
+	}
+	return nil, false
+}